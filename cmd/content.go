@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"fmt"
+	"gocli-teacher/content"
+	"gocli-teacher/exercises"
+	"gocli-teacher/packs"
+	"gocli-teacher/paths"
+	"gocli-teacher/progress"
+	"gocli-teacher/registry"
+	"gocli-teacher/tutorials"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+// contentDirs holds the directories given with --content-dir
+var contentDirs []string
+
+// contentLoaded records whether initContent has already run
+var contentLoaded bool
+
+// contentCmd represents the content command
+var contentCmd = &cobra.Command{
+	Use:   "content",
+	Short: "Work with lesson content",
+}
+
+func init() {
+	RootCmd.AddCommand(contentCmd)
+
+	RootCmd.PersistentFlags().StringSliceVar(&contentDirs, "content-dir", nil,
+		"Directory of extra lessons to layer over the built-in content (also "+content.EnvVar+")")
+
+	cobra.OnInitialize(initContent)
+
+	// Help is shown before the initializers run, so load content first
+	// to list lessons from content directories
+	defaultHelp := RootCmd.HelpFunc()
+	RootCmd.SetHelpFunc(func(cmd *cobra.Command, args []string) {
+		initContent()
+		defaultHelp(cmd, args)
+	})
+}
+
+// initContent layers installed packs and the configured content
+// directories over the built-in content and registers the lessons they contain
+func initContent() {
+	if contentLoaded {
+		return
+	}
+	contentLoaded = true
+
+	var dirs []string
+	if packsDir, err := packsDirectory(); err == nil {
+		installed, err := packs.List(packsDir)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: Some packs could not be loaded:\n%s\n", err)
+		}
+		for _, p := range installed {
+			dirs = append(dirs, p.Dir)
+		}
+	}
+	dirs = append(dirs, content.DirsFromEnv()...)
+	dirs = append(dirs, contentDirs...)
+
+	for _, dir := range dirs {
+		if err := content.AddDir(dir); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: Skipping content directory: %s\n", err)
+		}
+	}
+
+	if err := tutorials.Register(content.FS()); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: Some tutorials could not be loaded:\n%s\n", err)
+	}
+	if err := tutorials.RegisterQuizzes(content.FS()); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: Some question pools could not be loaded:\n%s\n", err)
+	}
+	if err := exercises.Register(content.FS()); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: Some exercises could not be loaded:\n%s\n", err)
+	}
+	if err := paths.Register(content.FS()); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: Some learning paths could not be loaded:\n%s\n", err)
+	}
+
+	if err := registry.Validate(); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: Invalid lesson references:\n%s\n", err)
+	}
+
+	// Refresh help text now that all lessons are known
+	tutorialCmd.Long = tutorialHelp()
+	exerciseCmd.Long = exerciseHelp()
+}
+
+// packsDirectory returns the directory content packs are installed into
+func packsDirectory() (string, error) {
+	configDir, err := progress.ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return packs.Dir(configDir), nil
+}
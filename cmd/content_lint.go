@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"fmt"
+	"gocli-teacher/content"
+	"gocli-teacher/lint"
+	"gocli-teacher/registry"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// lintModuleDir is where imports of code samples are resolved
+var lintModuleDir string
+
+// contentLintCmd type-checks the code in lessons and exercises
+var contentLintCmd = &cobra.Command{
+	Use:   "lint",
+	Short: "Type-check every code sample and reference solution and check lesson references",
+	Long: `Lint parses and type-checks the Go code in every tutorial and
+exercise, including content directories and installed packs.
+
+Complete programs are checked as they are. Fragments are checked as
+declarations or function bodies, so names they share with other samples
+are not reported as undefined.
+
+Prerequisites and learning path items have to name known lessons, and
+paths have to take each lesson after its prerequisites.
+
+Imports are resolved with the go command in --module-dir, which has to be
+inside a module that requires the packages the samples use.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		problems, err := lint.Content(content.FS(), lintModuleDir)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+			os.Exit(1)
+		}
+
+		for _, p := range problems {
+			fmt.Println(p)
+		}
+		count := len(problems)
+
+		// References between lessons and paths are checked when content
+		// loads, but only as a warning there
+		if err := registry.Validate(); err != nil {
+			references := strings.Split(err.Error(), "\n")
+			for _, problem := range references {
+				fmt.Println(problem)
+			}
+			count += len(references)
+		}
+
+		if count > 0 {
+			fmt.Printf("\n%d problems found\n", count)
+			os.Exit(1)
+		}
+		fmt.Println("All code samples type-check and lesson references are valid.")
+	},
+}
+
+func init() {
+	contentCmd.AddCommand(contentLintCmd)
+
+	contentLintCmd.Flags().StringVar(&lintModuleDir, "module-dir", ".", "Directory of the Go module used to resolve imports")
+}
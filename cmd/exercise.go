@@ -2,9 +2,12 @@ package cmd
 
 import (
         "fmt"
-        "gocli-teacher/exercises"
+        "gocli-teacher/grader"
         "gocli-teacher/progress"
+        "gocli-teacher/registry"
         "os"
+        "strings"
+        "time"
 
         "github.com/spf13/cobra"
 )
@@ -13,92 +16,110 @@ import (
 var exerciseCmd = &cobra.Command{
         Use:   "exercise [name]",
         Short: "Complete an exercise to practice CLI development",
-        Long: `The exercise command provides hands-on practice exercises 
-for building CLI applications in Go.
-
-Each exercise includes starter code that you can modify and run
-to complete the task. The exercises build on the concepts from
-the tutorials.
-
-Available exercises:
-  simple-cli       - Create a basic CLI tool
-  flag-exercise    - Practice using command-line flags
-  command-exercise - Create a CLI tool with subcommands
-  interactive      - Build an interactive CLI application
-`,
+        Long:  exerciseHelp(),
         Run: func(cmd *cobra.Command, args []string) {
                 if len(args) == 0 {
                         fmt.Println("Please specify an exercise. For example:")
                         fmt.Println("  gocli-teacher exercise simple-cli")
                         fmt.Println("\nAvailable exercises:")
-                        fmt.Println("  simple-cli, flag-exercise, command-exercise, interactive")
+                        fmt.Println("  " + registry.ExerciseNames())
                         return
                 }
 
-                exercise := args[0]
-                
-                // Map exercise names to their internal names
-                exerciseMap := map[string]string{
-                        "simple-cli":       "simple_cli",
-                        "simple_cli":       "simple_cli",
-                        "flag-exercise":    "flag_exercise",
-                        "flag_exercise":    "flag_exercise",
-                        "command-exercise": "command_exercise",
-                        "command_exercise": "command_exercise",
-                        "interactive":      "interactive_exercise",
-                }
+                name := args[0]
                 
-                // Normalize exercise name
-                normalizedExercise, exists := exerciseMap[exercise]
+                // Look up the exercise by name or alias
+                exercise, exists := registry.LookupExercise(name)
                 if !exists {
-                        fmt.Printf("Unknown exercise: %s\n", exercise)
-                        fmt.Println("Available exercises: simple-cli, flag-exercise, command-exercise, interactive")
+                        fmt.Printf("Unknown exercise: %s\n", name)
+                        fmt.Println("Available exercises: " + registry.ExerciseNames())
                         return
                 }
                 
-                // Initialize progress tracker
-                tracker, err := progress.New()
-                if err != nil {
-                        fmt.Fprintf(os.Stderr, "Warning: Could not load progress data: %s\n", err)
-                        // Continue without progress tracking
-                }
-                
-                // Show progress info if exercise was completed before
-                if tracker != nil && tracker.IsExerciseCompleted(normalizedExercise) {
-                        fmt.Printf("\nNote: You've already completed this exercise. Running it again for practice.\n\n")
-                }
-                
-                // Run the requested exercise
-                var completed bool
-                var score int
-                
-                switch normalizedExercise {
-                case "simple_cli":
-                        completed, score = exercises.RunSimpleCliExercise()
-                case "flag_exercise":
-                        completed, score = exercises.RunFlagExercise()
-                case "command_exercise":
-                        completed, score = exercises.RunCommandExercise()
-                case "interactive_exercise":
-                        completed, score = exercises.RunInteractiveExercise()
-                }
-                
-                // Mark exercise as completed if successful
-                if completed && tracker != nil {
-                        if err := tracker.MarkExerciseComplete(normalizedExercise, score); err != nil {
-                                fmt.Fprintf(os.Stderr, "Warning: Could not save progress: %s\n", err)
-                        } else {
-                                fmt.Printf("\nCongratulations! Exercise completed with score: %d/100\n", score)
-                                
-                                // Show recent progress
-                                tutorials := getAllTutorials()
-                                exercises := getAllExercises()
-                                fmt.Print(progress.FormatRecentProgress(tracker, tutorials, exercises))
-                        }
-                }
+                runExercise(exercise)
         },
 }
 
 func init() {
         RootCmd.AddCommand(exerciseCmd)
+        
+        // Add flags
+        exerciseCmd.Flags().BoolVar(&strictMode, "strict", false, "Refuse to start if prerequisites are not completed")
+}
+
+// runExercise runs an exercise and records its completion
+func runExercise(exercise registry.Exercise) {
+        // Initialize progress tracker
+        tracker, err := progress.New()
+        if err != nil {
+                fmt.Fprintf(os.Stderr, "Warning: Could not load progress data: %s\n", err)
+                // Continue without progress tracking
+        }
+        
+        // Show progress info if exercise was completed before
+        if tracker != nil && tracker.IsExerciseCompleted(exercise.ID) {
+                fmt.Printf("\nNote: You've already completed this exercise. Running it again for practice.\n\n")
+        }
+        
+        // Check prerequisites
+        if !checkPrerequisites(tracker, exercise.Info) {
+                os.Exit(1)
+        }
+        
+        // Remember where the learner's program is, so that check and later
+        // runs find it even if the workspace root changes
+        dir := adoptLegacy(exercise, exerciseDir(exercise))
+        if tracker != nil {
+                if err := tracker.SetWorkspace(exercise.ID, dir); err != nil {
+                        fmt.Fprintf(os.Stderr, "Warning: Could not save the exercise's location: %s\n", err)
+                }
+        }
+        
+        // Record every grading as an attempt, timed from the start of the
+        // exercise or the grading before it
+        since := time.Now()
+        graded := func(report grader.Report) {
+                if tracker != nil {
+                        recordAttempt(tracker, exercise, dir, report, time.Since(since))
+                }
+                since = time.Now()
+        }
+        
+        // Run the requested exercise
+        completed, score := exercise.Run(dir, graded)
+        
+        // Mark exercise as completed if successful
+        if completed && tracker != nil {
+                if err := tracker.MarkExerciseComplete(exercise.ID, score); err != nil {
+                        fmt.Fprintf(os.Stderr, "Warning: Could not save progress: %s\n", err)
+                } else {
+                        fmt.Printf("\nCongratulations! Exercise completed with score: %d/100\n", score)
+                        
+                        // Show recent progress
+                        tutorials := getAllTutorials()
+                        exercises := getAllExercises()
+                        fmt.Print(progress.FormatRecentProgress(tracker, tutorials, exercises))
+                }
+        }
+}
+
+// exerciseHelp builds the long help text from the registered exercises
+func exerciseHelp() string {
+        var sb strings.Builder
+        sb.WriteString(`The exercise command provides hands-on practice exercises 
+for building CLI applications in Go.
+
+Each exercise includes starter code that you can modify and run
+to complete the task. The exercises build on the concepts from
+the tutorials.
+
+Run 'gocli-teacher exercise check [name]' at any time to grade
+your program without going through the exercise again.
+
+Available exercises:
+`)
+        for _, e := range registry.Exercises() {
+                sb.WriteString(fmt.Sprintf("  %-16s - %s\n", e.DisplayName(), e.Description))
+        }
+        return sb.String()
 }
@@ -0,0 +1,162 @@
+package cmd
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"gocli-teacher/grader"
+	"gocli-teacher/progress"
+	"gocli-teacher/registry"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// checkDir is the directory of the program to check, if not the exercise's own
+var checkDir string
+
+// exerciseCheckCmd grades the learner's program without the walkthrough
+var exerciseCheckCmd = &cobra.Command{
+	Use:   "check <name>",
+	Short: "Grade your program for an exercise",
+	Long: `Check builds the main.go in the exercise's directory, runs it against
+the exercise's test cases and prints the result of each one. The exercise
+is marked as completed when every test case passes.
+
+Check never changes your files. It exits with status 1 if the program does
+not build or a test case fails, so it can be used in scripts.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		exercise := mustLookupExercise(args[0])
+
+		dir := checkDir
+		if dir == "" {
+			dir = exerciseDir(exercise)
+		}
+
+		if !checkExercise(context.Background(), exercise, dir, time.Time{}) {
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	exerciseCmd.AddCommand(exerciseCheckCmd)
+
+	exerciseCheckCmd.Flags().StringVar(&checkDir, "dir", "", "Directory of the program to check (default: the exercise's workspace)")
+}
+
+// mustLookupExercise returns the exercise with the given name or alias, or exits
+func mustLookupExercise(name string) registry.Exercise {
+	e, ok := registry.LookupExercise(name)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Unknown exercise: %s\n", name)
+		fmt.Fprintln(os.Stderr, "Available exercises: "+registry.ExerciseNames())
+		os.Exit(1)
+	}
+	return e
+}
+
+// checkExercise grades the program in dir, prints a report, records the
+// grading as an attempt and records the exercise as completed if every test
+// case passes. Since is when the learner started on this version of the
+// program, or zero if that is not known. It reports whether every case
+// passed. When ctx is done grading stops and nothing more is printed.
+func checkExercise(ctx context.Context, exercise registry.Exercise, dir string, since time.Time) bool {
+	if exercise.Spec.Empty() {
+		fmt.Fprintf(os.Stderr, "Error: Exercise %s has no test cases or rubric to check\n", exercise.DisplayName())
+		return false
+	}
+
+	fmt.Printf("Checking %s in %s\n\n", exercise.DisplayName(), dir)
+	diagnostics, err := grader.Diagnose(dir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+		return false
+	}
+	fmt.Print(grader.FormatDiagnostics(diagnostics))
+
+	report, err := grader.GradeContext(ctx, dir, exercise.Spec)
+	if ctx.Err() != nil {
+		return false
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+		return false
+	}
+	fmt.Print(grader.FormatReport(report))
+
+	tracker := loadTracker()
+	if tracker != nil {
+		var duration time.Duration
+		if !since.IsZero() {
+			duration = time.Since(since)
+		}
+		recordAttempt(tracker, exercise, dir, report, duration)
+	}
+
+	if !report.Completed() {
+		if exercise.Solution != "" {
+			fmt.Printf("\nTo see how your program differs from the solution, run 'gocli-teacher exercise diff %s'.\n", exercise.DisplayName())
+		}
+		return false
+	}
+
+	if tracker != nil {
+		if err := tracker.MarkExerciseComplete(exercise.ID, report.Score()); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: Could not save progress: %s\n", err)
+		} else {
+			fmt.Printf("\nExercise %s completed.\n", exercise.DisplayName())
+		}
+	}
+	return true
+}
+
+// recordAttempt saves a grading of the program in dir as an attempt at the
+// exercise, unless the program is the same as in the last attempt, as when
+// an editor saves it again unchanged. The duration is the time spent on the
+// program, or zero if it is not known.
+func recordAttempt(tracker *progress.Tracker, exercise registry.Exercise, dir string, report grader.Report, duration time.Duration) {
+	source := programHash(dir)
+	if last, ok := tracker.GetLatestExerciseAttempt(exercise.ID); ok && source != "" && last.Source == source {
+		return
+	}
+
+	attempt := progress.ExerciseAttempt{
+		Exercise:  exercise.ID,
+		Score:     report.Score(),
+		Completed: report.Completed(),
+		Duration:  duration.Round(time.Second),
+		HintsUsed: report.Hints(),
+		Source:    source,
+	}
+	for _, result := range report.Results {
+		attempt.Results = append(attempt.Results, progress.TestResult{
+			Name:    result.Case.Name,
+			Passed:  result.Passed(),
+			Outcome: result.Outcome.String(),
+		})
+	}
+	for _, result := range report.Rubric {
+		attempt.Results = append(attempt.Results, progress.TestResult{
+			Name:   result.Rule.Name,
+			Check:  true,
+			Passed: result.Passed,
+		})
+	}
+	if err := tracker.RecordExerciseAttempt(attempt); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: Could not save the attempt: %s\n", err)
+	}
+}
+
+// programHash returns the SHA-256 of the main.go in dir, or "" if it can't
+// be read
+func programHash(dir string) string {
+	source, err := os.ReadFile(filepath.Join(dir, "main.go"))
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%x", sha256.Sum256(source))
+}
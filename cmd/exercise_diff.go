@@ -0,0 +1,150 @@
+package cmd
+
+import (
+	"fmt"
+	"gocli-teacher/diff"
+	"gocli-teacher/registry"
+	"gocli-teacher/utils"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// Flags of exercise diff
+var (
+	diffSideBySide       bool
+	diffIgnoreWhitespace bool
+	diffIgnoreComments   bool
+	diffNormalize        bool
+	diffContext          int
+	diffWidth            int
+	diffColor            string
+)
+
+// exerciseDiffCmd compares the learner's program with the reference solution
+var exerciseDiffCmd = &cobra.Command{
+	Use:   "diff <name>",
+	Short: "Compare your program with the reference solution",
+	Long: `Diff shows how the main.go in the exercise's directory differs from the
+exercise's reference solution, as a unified diff or in two columns.
+
+Differences in whitespace or comments can be ignored. With --ast both
+programs are parsed and printed again before they are compared, so only
+differences in the code itself are shown, whatever its formatting.`,
+	Example: `  gocli-teacher exercise diff simple-cli
+  gocli-teacher exercise diff simple-cli --side-by-side --ignore-whitespace
+  gocli-teacher exercise diff simple-cli --ast`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		exercise := mustLookupExercise(args[0])
+		if exercise.Solution == "" {
+			fmt.Fprintf(os.Stderr, "Error: Exercise %s has no reference solution\n", exercise.DisplayName())
+			os.Exit(1)
+		}
+
+		color, err := useColor(diffColor)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+			os.Exit(1)
+		}
+
+		dir := checkDir
+		if dir == "" {
+			dir = exerciseDir(exercise)
+		}
+		source, err := os.ReadFile(filepath.Join(dir, "main.go"))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: Failed to read your program: %s\n", err)
+			os.Exit(1)
+		}
+
+		opts := diff.Options{
+			IgnoreWhitespace: diffIgnoreWhitespace,
+			IgnoreComments:   diffIgnoreComments,
+			Normalize:        diffNormalize,
+			Context:          max(diffContext, 0),
+		}
+		if !printSolutionDiff(exercise, string(source), opts, color) {
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	exerciseCmd.AddCommand(exerciseDiffCmd)
+
+	flags := exerciseDiffCmd.Flags()
+	flags.BoolVarP(&diffSideBySide, "side-by-side", "y", false, "Show the programs in two columns")
+	flags.BoolVarP(&diffIgnoreWhitespace, "ignore-whitespace", "w", false, "Ignore spaces, tabs and blank lines")
+	flags.BoolVar(&diffIgnoreComments, "ignore-comments", false, "Ignore comments")
+	flags.BoolVar(&diffNormalize, "ast", false, "Compare the programs' syntax, ignoring formatting and comments")
+	flags.IntVarP(&diffContext, "context", "U", 3, "Unchanged lines shown around each difference")
+	flags.IntVar(&diffWidth, "width", 0, "Width of the side-by-side view (default: the terminal's)")
+	flags.StringVar(&diffColor, "color", "auto", "When to color the output: auto, always or never")
+	flags.StringVar(&checkDir, "dir", "", "Directory of the program to compare (default: the exercise's workspace)")
+}
+
+// useColor reports whether output is colored for a --color setting
+func useColor(setting string) (bool, error) {
+	switch setting {
+	case "always":
+		return true, nil
+	case "never":
+		return false, nil
+	case "auto":
+		return utils.ColorEnabled(os.Stdout), nil
+	default:
+		return false, fmt.Errorf("invalid --color %q, use auto, always or never", setting)
+	}
+}
+
+// printSolutionDiff prints how source differs from the exercise's solution.
+// It reports whether the comparison could be made.
+func printSolutionDiff(exercise registry.Exercise, source string, opts diff.Options, color bool) bool {
+	yours := diff.File{Name: "main.go", Source: source}
+	solution := diff.File{Name: "solution", Source: exercise.Solution}
+	hunks, err := diff.Compare(yours, solution, opts)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+		if opts.Normalize {
+			fmt.Fprintln(os.Stderr, "Fix the syntax errors, or compare without --ast.")
+		}
+		return false
+	}
+
+	if len(hunks) == 0 {
+		fmt.Printf("Your program matches the solution%s.\n", ignoredDifferences(opts))
+		return true
+	}
+	if diffSideBySide {
+		width := diffWidth
+		if width <= 0 {
+			width = utils.TerminalWidth(os.Stdout, 120)
+		}
+		diff.SideBySide(os.Stdout, yours.Name, solution.Name, hunks, width, color)
+	} else {
+		diff.Unified(os.Stdout, yours.Name, solution.Name, hunks, color)
+	}
+	return true
+}
+
+// ignoredDifferences describes what a comparison ignored, for a sentence
+// that says the programs match
+func ignoredDifferences(opts diff.Options) string {
+	var ignored []string
+	if opts.Normalize {
+		ignored = append(ignored, "formatting")
+	}
+	if opts.IgnoreWhitespace && !opts.Normalize {
+		ignored = append(ignored, "whitespace")
+	}
+	if opts.IgnoreComments || opts.Normalize {
+		ignored = append(ignored, "comments")
+	}
+	if len(ignored) == 0 {
+		return ""
+	}
+	return " apart from " + strings.Join(ignored, " and ")
+}
@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"gocli-teacher/utils"
+	"io/fs"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// watchInterval is how often the exercise directory is checked for changes
+var watchInterval time.Duration
+
+// minWatchInterval is the shortest --interval accepted, so that watching
+// does not keep a CPU busy
+const minWatchInterval = 100 * time.Millisecond
+
+// exerciseWatchCmd re-grades the learner's program whenever it changes
+var exerciseWatchCmd = &cobra.Command{
+	Use:   "watch <name>",
+	Short: "Grade your program for an exercise every time you save it",
+	Long: `Watch checks the exercise's directory for changes and, after every
+save, builds your program, runs the test cases and redraws the results.
+Keep it running in a terminal next to your editor and stop it with Ctrl+C.
+
+Like check, the exercise is marked as completed when every test case passes.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if watchInterval < minWatchInterval {
+			fmt.Fprintf(os.Stderr, "Error: --interval must be at least %s\n", minWatchInterval)
+			os.Exit(1)
+		}
+		exercise := mustLookupExercise(args[0])
+
+		dir := checkDir
+		if dir == "" {
+			dir = exerciseDir(exercise)
+		}
+		if exercise.Spec.Empty() {
+			fmt.Fprintf(os.Stderr, "Error: Exercise %s has no test cases or rubric to check\n", exercise.DisplayName())
+			os.Exit(1)
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+
+		// Each check after the first is timed from the one before it
+		last := ""
+		var since time.Time
+		for {
+			if dirState(dir) != last {
+				// Let editors finish writing before building
+				time.Sleep(watchInterval / 4)
+				last = dirState(dir)
+
+				utils.ClearScreen()
+				checkExercise(ctx, exercise, dir, since)
+				if ctx.Err() == nil {
+					since = time.Now()
+					fmt.Printf("\nLast checked at %s. Watching %s for changes, press Ctrl+C to stop.\n",
+						time.Now().Format("15:04:05"), dir)
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				fmt.Println("\nStopped watching.")
+				return
+			case <-time.After(watchInterval):
+			}
+		}
+	},
+}
+
+func init() {
+	exerciseCmd.AddCommand(exerciseWatchCmd)
+
+	exerciseWatchCmd.Flags().StringVar(&checkDir, "dir", "", "Directory of the program to check (default: the exercise's workspace)")
+	exerciseWatchCmd.Flags().DurationVar(&watchInterval, "interval", time.Second, "How often to look for changes")
+}
+
+// dirState summarizes the names, sizes and modification times of the files
+// in dir, so that any change to them changes the summary. Hidden and vendor
+// directories are left out. A directory that cannot be read is summarized
+// by the error.
+func dirState(dir string) string {
+	var sb strings.Builder
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path != dir && (strings.HasPrefix(d.Name(), ".") || d.Name() == "vendor") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(&sb, "%s %d %d\n", path, info.Size(), info.ModTime().UnixNano())
+		return nil
+	})
+	if err != nil {
+		return err.Error()
+	}
+	return sb.String()
+}
@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"fmt"
+	"gocli-teacher/packs"
+	"gocli-teacher/utils"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+// packCmd represents the pack command
+var packCmd = &cobra.Command{
+	Use:   "pack",
+	Short: "Manage installable content packs",
+	Long: `Content packs bundle extra tutorials and exercises.
+
+A pack is a directory, .zip or .tar.gz archive with a pack.yaml manifest
+at its root, alongside the same tutorials/ and exercises/ directories used
+by --content-dir. Installed packs are loaded automatically.`,
+}
+
+// packInstallCmd installs a pack from a directory or archive
+var packInstallCmd = &cobra.Command{
+	Use:   "install <archive.tar.gz|archive.zip|dir>",
+	Short: "Install or upgrade a content pack",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		dir := mustPacksDirectory()
+
+		pack, err := packs.Install(dir, args[0], Version)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: Failed to install pack: %s\n", err)
+			os.Exit(1)
+		}
+
+		fmt.Printf("Installed %s %s\n", pack.Name, pack.Version)
+	},
+}
+
+// packListCmd lists installed packs
+var packListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List installed content packs",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		installed, err := packs.List(mustPacksDirectory())
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %s\n", err)
+		}
+
+		if len(installed) == 0 {
+			fmt.Println("No content packs installed.")
+			fmt.Println("Install one with 'gocli-teacher pack install <archive|dir>'")
+			return
+		}
+
+		var rows [][]string
+		for _, p := range installed {
+			rows = append(rows, []string{p.Name, p.Version, p.Description})
+		}
+		fmt.Print(utils.FormatAsTable([]string{"Name", "Version", "Description"}, rows))
+	},
+}
+
+// packRemoveCmd removes an installed pack
+var packRemoveCmd = &cobra.Command{
+	Use:   "remove <name>",
+	Short: "Remove an installed content pack",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := packs.Remove(mustPacksDirectory(), args[0]); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: Failed to remove pack: %s\n", err)
+			os.Exit(1)
+		}
+
+		fmt.Printf("Removed %s\n", args[0])
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(packCmd)
+	packCmd.AddCommand(packInstallCmd)
+	packCmd.AddCommand(packListCmd)
+	packCmd.AddCommand(packRemoveCmd)
+}
+
+// mustPacksDirectory returns the packs directory or exits
+func mustPacksDirectory() string {
+	dir, err := packsDirectory()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: Failed to get config directory: %s\n", err)
+		os.Exit(1)
+	}
+	return dir
+}
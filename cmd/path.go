@@ -0,0 +1,171 @@
+package cmd
+
+import (
+	"fmt"
+	"gocli-teacher/progress"
+	"gocli-teacher/registry"
+	"gocli-teacher/utils"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+// pathCmd represents the path command
+var pathCmd = &cobra.Command{
+	Use:   "path",
+	Short: "Follow a learning path of tutorials and exercises",
+	Long: `A learning path is an ordered curriculum that mixes tutorials
+and exercises, such as cobra-fundamentals or interactive-tools.
+
+Start a path to make it your active path. Running 'path start' again
+continues with the next tutorial or exercise you have not completed.`,
+}
+
+// pathListCmd lists the available learning paths
+var pathListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the available learning paths",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		all := registry.Paths()
+		if len(all) == 0 {
+			fmt.Println("No learning paths available.")
+			return
+		}
+
+		tracker := loadTracker()
+
+		var rows [][]string
+		for _, p := range all {
+			info := getPathInfo(p)
+			status := "-"
+			if tracker != nil {
+				completed, total, _ := tracker.GetPathProgress(info)
+				status = fmt.Sprintf("%d/%d", completed, total)
+				if tracker.ActivePath() == p.ID {
+					status += " (active)"
+				}
+			}
+			rows = append(rows, []string{p.ID, p.Title, status, p.Description})
+		}
+		fmt.Print(utils.FormatAsTable([]string{"Name", "Title", "Progress", "Description"}, rows))
+	},
+}
+
+// pathStartCmd makes a path active and runs its next item
+var pathStartCmd = &cobra.Command{
+	Use:   "start <name>",
+	Short: "Start or continue a learning path",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		p := mustLookupPath(args[0])
+		for _, id := range p.Items {
+			if _, ok := registry.Get(id); !ok {
+				fmt.Fprintf(os.Stderr, "Error: Unknown item in path %s: %s\n", p.ID, id)
+				os.Exit(1)
+			}
+		}
+
+		tracker, err := progress.New()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: Failed to load progress data: %s\n", err)
+			os.Exit(1)
+		}
+		if err := tracker.SetActivePath(p.ID); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: Could not save progress: %s\n", err)
+		}
+
+		info := getPathInfo(p)
+		fmt.Print(progress.FormatPathStatus(tracker, info))
+
+		next, ok := tracker.NextItem(info.Items)
+		if !ok {
+			fmt.Printf("\nYou have completed the %s path!\n", p.ID)
+			return
+		}
+
+		utils.TestMode = testMode
+		if testMode {
+			fmt.Println("Running in non-interactive test mode")
+		}
+
+		var exercise registry.Exercise
+		var tutorial registry.Tutorial
+		if next.Type == string(registry.KindExercise) {
+			exercise, ok = registry.LookupExercise(next.Name)
+		} else {
+			tutorial, ok = registry.LookupTutorial(next.Name)
+		}
+		if !ok {
+			fmt.Fprintf(os.Stderr, "Error: Unknown item in path %s: %s %s\n", p.ID, next.Type, next.Name)
+			os.Exit(1)
+		}
+
+		fmt.Printf("\nStarting %s %s...\n", next.Type, next.Name)
+		utils.PressEnterToContinue()
+
+		if next.Type == string(registry.KindExercise) {
+			runExercise(exercise)
+		} else {
+			runTutorial(tutorial)
+		}
+	},
+}
+
+// pathStatusCmd shows progress through a learning path
+var pathStatusCmd = &cobra.Command{
+	Use:   "status [name]",
+	Short: "Show progress through the active or named learning path",
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		tracker, err := progress.New()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: Failed to load progress data: %s\n", err)
+			os.Exit(1)
+		}
+
+		name := tracker.ActivePath()
+		if len(args) > 0 {
+			name = args[0]
+		}
+		if name == "" {
+			fmt.Println("You have not started a learning path yet.")
+			fmt.Println("Start one with 'gocli-teacher path start <name>'")
+			fmt.Println("Available paths: " + registry.PathNames())
+			return
+		}
+
+		fmt.Print(progress.FormatPathStatus(tracker, getPathInfo(mustLookupPath(name))))
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(pathCmd)
+	pathCmd.AddCommand(pathListCmd)
+	pathCmd.AddCommand(pathStartCmd)
+	pathCmd.AddCommand(pathStatusCmd)
+
+	pathStartCmd.Flags().BoolVarP(&testMode, "test", "t", false, "Run in non-interactive test mode")
+	pathStartCmd.Flags().BoolVar(&strictMode, "strict", false, "Refuse to start if prerequisites are not completed")
+}
+
+// mustLookupPath finds a learning path or exits
+func mustLookupPath(name string) registry.Path {
+	p, ok := registry.LookupPath(name)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Unknown learning path: %s\n", name)
+		fmt.Fprintln(os.Stderr, "Available paths: "+registry.PathNames())
+		os.Exit(1)
+	}
+	return p
+}
+
+// loadTracker returns the progress tracker, or nil with a warning if it cannot be loaded
+func loadTracker() *progress.Tracker {
+	tracker, err := progress.New()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: Could not load progress data: %s\n", err)
+		return nil
+	}
+	return tracker
+}
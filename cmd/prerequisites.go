@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"fmt"
+	"gocli-teacher/progress"
+	"gocli-teacher/registry"
+)
+
+// strictMode refuses to start lessons whose prerequisites are unfinished
+var strictMode bool
+
+// isCompleted reports whether the tracker has recorded a tutorial or exercise as done
+func isCompleted(tracker *progress.Tracker, info registry.Info) bool {
+	if info.Kind == registry.KindExercise {
+		return tracker.IsExerciseCompleted(info.ID)
+	}
+	return tracker.IsTutorialCompleted(info.ID)
+}
+
+// checkPrerequisites warns about unfinished prerequisites of a lesson.
+// It returns false if the lesson should not be started.
+func checkPrerequisites(tracker *progress.Tracker, info registry.Info) bool {
+	if tracker == nil {
+		return true
+	}
+
+	var missing []registry.Info
+	for _, id := range info.Prerequisites {
+		prereq, ok := registry.Get(id)
+		if ok && !isCompleted(tracker, prereq) {
+			missing = append(missing, prereq)
+		}
+	}
+	if len(missing) == 0 {
+		return true
+	}
+
+	if strictMode {
+		fmt.Printf("You need to finish these before starting %s:\n", info.DisplayName())
+	} else {
+		fmt.Printf("We recommend finishing these before starting %s:\n", info.DisplayName())
+	}
+	for _, prereq := range missing {
+		fmt.Printf("  - %s (%s): gocli-teacher %s %s\n", prereq.DisplayName(),
+			prereq.Description, commandFor(prereq.Kind), prereq.DisplayName())
+	}
+
+	if strictMode {
+		return false
+	}
+	fmt.Println()
+	return true
+}
+
+// commandFor returns the gocli-teacher command that runs a kind of lesson
+func commandFor(kind registry.Kind) string {
+	if kind == registry.KindExercise {
+		return "exercise"
+	}
+	return "tutorial"
+}
+
+// itemInfo converts a registry entry for the progress display
+func itemInfo(info registry.Info) progress.ItemInfo {
+	return progress.ItemInfo{
+		Type:        string(info.Kind),
+		Name:        info.ID,
+		Description: info.Description,
+	}
+}
+
+// getRecommendedOrder returns every tutorial and exercise in recommended order
+func getRecommendedOrder() []progress.ItemInfo {
+	var items []progress.ItemInfo
+	for _, info := range registry.RecommendedOrder() {
+		items = append(items, itemInfo(info))
+	}
+	return items
+}
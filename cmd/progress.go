@@ -3,6 +3,8 @@ package cmd
 import (
         "fmt"
         "gocli-teacher/progress"
+        "gocli-teacher/registry"
+        "gocli-teacher/review"
         "os"
 
         "github.com/spf13/cobra"
@@ -23,6 +25,7 @@ and provides statistics on your overall progress.`,
 // reset flag for progress command
 var resetProgress bool
 var showRecent bool
+var showOrder bool
 
 func init() {
         RootCmd.AddCommand(progressCmd)
@@ -30,6 +33,7 @@ func init() {
         // Add flags
         progressCmd.Flags().BoolVarP(&resetProgress, "reset", "r", false, "Reset all progress tracking data")
         progressCmd.Flags().BoolVarP(&showRecent, "recent", "", false, "Show only recent activity")
+        progressCmd.Flags().BoolVarP(&showOrder, "order", "", false, "Show the recommended order of tutorials and exercises")
 }
 
 func showProgress(cmd *cobra.Command, args []string) {
@@ -46,6 +50,11 @@ func showProgress(cmd *cobra.Command, args []string) {
                         fmt.Fprintf(os.Stderr, "Error: Failed to reset progress: %s\n", err)
                         os.Exit(1)
                 }
+                if deck, err := review.New(); err == nil {
+                        if err := deck.Reset(); err != nil {
+                                fmt.Fprintf(os.Stderr, "Warning: Failed to reset review schedule: %s\n", err)
+                        }
+                }
                 fmt.Println("Progress data has been reset.")
                 return
         }
@@ -55,30 +64,50 @@ func showProgress(cmd *cobra.Command, args []string) {
         exercises := getAllExercises()
 
         // Display progress
-        if showRecent {
+        if showOrder {
+                fmt.Print(progress.FormatRecommendedOrder(tracker, getRecommendedOrder()))
+        } else if showRecent {
                 fmt.Print(progress.FormatRecentProgress(tracker, tutorials, exercises))
         } else {
-                fmt.Print(progress.FormatAllProgress(tracker, tutorials, exercises))
+                fmt.Print(progress.FormatAllProgress(tracker, tutorials, exercises, getAllPaths()))
         }
 }
 
 // getAllTutorials returns info about all available tutorials
 func getAllTutorials() []progress.TutorialInfo {
-        return []progress.TutorialInfo{
-                {Name: "basics", Description: "Basic CLI structure and command line arguments"},
-                {Name: "flags", Description: "Working with command line flags"},
-                {Name: "commands", Description: "Creating and organizing subcommands"},
-                {Name: "interactive", Description: "Building interactive CLI applications"},
-                {Name: "best_practices", Description: "Best practices for CLI development"},
+        var tutorials []progress.TutorialInfo
+        for _, t := range registry.Tutorials() {
+                tutorials = append(tutorials, progress.TutorialInfo{Name: t.ID, Description: t.Description})
         }
+        return tutorials
 }
 
 // getAllExercises returns info about all available exercises
 func getAllExercises() []progress.ExerciseInfo {
-        return []progress.ExerciseInfo{
-                {Name: "simple_cli", Description: "Build a simple CLI tool", Difficulty: "Easy"},
-                {Name: "flag_exercise", Description: "Create a CLI with multiple flags", Difficulty: "Medium"},
-                {Name: "command_exercise", Description: "Implement a CLI with subcommands", Difficulty: "Medium"},
-                {Name: "interactive_exercise", Description: "Build an interactive CLI", Difficulty: "Hard"},
+        var exercises []progress.ExerciseInfo
+        for _, e := range registry.Exercises() {
+                exercises = append(exercises, progress.ExerciseInfo{Name: e.ID, Description: e.Description, Difficulty: e.Difficulty})
         }
-}
\ No newline at end of file
+        return exercises
+}
+
+// getAllPaths returns info about all available learning paths
+func getAllPaths() []progress.PathInfo {
+        var paths []progress.PathInfo
+        for _, p := range registry.Paths() {
+                paths = append(paths, getPathInfo(p))
+        }
+        return paths
+}
+
+// getPathInfo returns info about a learning path and its items.
+// Items that are not registered are left out.
+func getPathInfo(p registry.Path) progress.PathInfo {
+        info := progress.PathInfo{Name: p.ID, Title: p.Title, Description: p.Description}
+        for _, id := range p.Items {
+                if item, ok := registry.Get(id); ok {
+                        info.Items = append(info.Items, itemInfo(item))
+                }
+        }
+        return info
+}
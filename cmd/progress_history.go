@@ -0,0 +1,190 @@
+package cmd
+
+import (
+	"fmt"
+	"gocli-teacher/progress"
+	"gocli-teacher/registry"
+	"gocli-teacher/utils"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// historyAttempt is the attempt whose results are shown, counting from 1
+var historyAttempt int
+
+// progressHistoryCmd shows every attempt at an exercise or tutorial quiz
+var progressHistoryCmd = &cobra.Command{
+	Use:   "history <item>",
+	Short: "Show your attempts at an exercise or tutorial quiz",
+	Long: `History lists every time your program for an exercise was graded, with
+its score, the test cases and code checks it passed, the time spent on it
+and the hints shown, followed by your best and latest attempt and the one
+that first passed. Use --attempt to see the result of each test case of
+one attempt.
+
+For a tutorial, history lists your attempts at its quiz. An exercise and a
+tutorial with the same name, such as interactive, show the exercise.`,
+	Example: `  gocli-teacher progress history simple-cli
+  gocli-teacher progress history simple-cli --attempt 2
+  gocli-teacher progress history basics`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		tracker, err := progress.New()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: Failed to load progress data: %s\n", err)
+			os.Exit(1)
+		}
+
+		if exercise, ok := registry.LookupExercise(args[0]); ok {
+			showExerciseHistory(tracker, exercise)
+			return
+		}
+		if tutorial, ok := registry.LookupTutorial(args[0]); ok {
+			showQuizHistory(tracker, tutorial)
+			return
+		}
+		fmt.Fprintf(os.Stderr, "Unknown tutorial or exercise: %s\n", args[0])
+		fmt.Fprintln(os.Stderr, "Available exercises: "+registry.ExerciseNames())
+		fmt.Fprintln(os.Stderr, "Available tutorials: "+registry.TutorialNames())
+		os.Exit(1)
+	},
+}
+
+func init() {
+	progressCmd.AddCommand(progressHistoryCmd)
+
+	progressHistoryCmd.Flags().IntVar(&historyAttempt, "attempt", 0, "Show the result of every test case of this attempt")
+}
+
+// showExerciseHistory prints the attempts at an exercise
+func showExerciseHistory(tracker *progress.Tracker, exercise registry.Exercise) {
+	attempts := tracker.GetExerciseAttempts(exercise.ID)
+	if len(attempts) == 0 {
+		fmt.Printf("You haven't checked a program for %s yet.\n", exercise.DisplayName())
+		fmt.Printf("Run 'gocli-teacher exercise %s' to start it.\n", exercise.DisplayName())
+		return
+	}
+
+	if historyAttempt != 0 {
+		if historyAttempt < 1 || historyAttempt > len(attempts) {
+			fmt.Fprintf(os.Stderr, "Error: --attempt must be between 1 and %d\n", len(attempts))
+			os.Exit(1)
+		}
+		showAttemptResults(exercise, historyAttempt, attempts[historyAttempt-1])
+		return
+	}
+
+	var rows [][]string
+	for i, a := range attempts {
+		tests, checks := attemptCounts(a)
+		status := ""
+		if a.Completed {
+			status = "yes"
+		}
+		rows = append(rows, []string{
+			fmt.Sprint(i + 1),
+			a.GradedAt.Format("Jan 02, 2006 15:04"),
+			fmt.Sprintf("%d%%", a.Score),
+			tests,
+			checks,
+			formatDuration(a.Duration),
+			fmt.Sprint(a.HintsUsed),
+			status,
+		})
+	}
+	fmt.Printf("Attempts at %s:\n\n", exercise.DisplayName())
+	fmt.Print(utils.FormatAsTable([]string{"#", "Graded", "Score", "Tests", "Checks", "Time", "Hints", "Passed"}, rows))
+
+	fmt.Println()
+	if best, ok := tracker.GetBestExerciseAttempt(exercise.ID); ok {
+		fmt.Printf("Best:         %d%% on %s\n", best.Score, best.GradedAt.Format("Jan 02, 2006 15:04"))
+	}
+	if latest, ok := tracker.GetLatestExerciseAttempt(exercise.ID); ok {
+		fmt.Printf("Latest:       %d%% on %s\n", latest.Score, latest.GradedAt.Format("Jan 02, 2006 15:04"))
+	}
+	if first, tries, ok := tracker.GetFirstPassingAttempt(exercise.ID); ok {
+		fmt.Printf("First passed: attempt %d of %d, on %s\n", tries, len(attempts), first.GradedAt.Format("Jan 02, 2006 15:04"))
+	} else {
+		fmt.Printf("First passed: not yet, after %d attempts\n", len(attempts))
+	}
+}
+
+// attemptCounts returns how many test cases and code checks an attempt
+// passed, out of how many
+func attemptCounts(a progress.ExerciseAttempt) (string, string) {
+	var tests, testsPassed, checks, checksPassed int
+	for _, r := range a.Results {
+		if r.Check {
+			checks++
+			if r.Passed {
+				checksPassed++
+			}
+			continue
+		}
+		tests++
+		if r.Passed {
+			testsPassed++
+		}
+	}
+	formatCount := func(passed, total int) string {
+		if total == 0 {
+			return "-"
+		}
+		return fmt.Sprintf("%d/%d", passed, total)
+	}
+	return formatCount(testsPassed, tests), formatCount(checksPassed, checks)
+}
+
+// showAttemptResults prints the result of every test case and code check
+// of one attempt
+func showAttemptResults(exercise registry.Exercise, number int, a progress.ExerciseAttempt) {
+	fmt.Printf("Attempt %d at %s, graded %s: %d%%\n\n", number, exercise.DisplayName(),
+		a.GradedAt.Format("Jan 02, 2006 15:04"), a.Score)
+	for _, r := range a.Results {
+		status := "[✓]"
+		if !r.Passed {
+			status = "[✗]"
+		}
+		name := r.Name
+		if r.Check {
+			name = "Code check: " + name
+		}
+		fmt.Printf("%s %s", status, name)
+		if !r.Passed && r.Outcome != "" {
+			fmt.Printf(" (%s)", r.Outcome)
+		}
+		fmt.Println()
+	}
+	fmt.Printf("\nTime spent: %s, hints shown: %d\n", formatDuration(a.Duration), a.HintsUsed)
+}
+
+// showQuizHistory prints the attempts at a tutorial's quiz
+func showQuizHistory(tracker *progress.Tracker, tutorial registry.Tutorial) {
+	attempts := tracker.GetQuizAttempts(tutorial.ID)
+	if len(attempts) == 0 {
+		fmt.Printf("You haven't taken the %s quiz yet.\n", tutorial.DisplayName())
+		return
+	}
+
+	var rows [][]string
+	for i, a := range attempts {
+		rows = append(rows, []string{
+			fmt.Sprint(i + 1),
+			a.CompletedAt.Format("Jan 02, 2006 15:04"),
+			a.Mode,
+			fmt.Sprintf("%d/%d", a.Correct(), len(a.Answers)),
+		})
+	}
+	fmt.Printf("Quiz attempts for %s:\n\n", tutorial.DisplayName())
+	fmt.Print(utils.FormatAsTable([]string{"#", "Taken", "Mode", "Correct"}, rows))
+}
+
+// formatDuration formats the time spent on an attempt, or - if it is unknown
+func formatDuration(d time.Duration) string {
+	if d == 0 {
+		return "-"
+	}
+	return d.Round(time.Second).String()
+}
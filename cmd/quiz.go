@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"fmt"
+	"gocli-teacher/quiz"
+	"gocli-teacher/registry"
+	"gocli-teacher/utils"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+// quizCount is the number of questions to ask
+var quizCount int
+
+// quizAllTopics draws questions from every topic
+var quizAllTopics bool
+
+// quizCmd represents the quiz command
+var quizCmd = &cobra.Command{
+	Use:   "quiz [topic]",
+	Short: "Take a quick quiz without replaying a tutorial",
+	Long: `The quiz command asks questions drawn at random from a topic's
+question pool, which holds the tutorial's own quiz questions and more.
+Your score is saved with your progress, and questions you miss are
+scheduled for review.
+
+Topics are the tutorial names: ` + "`gocli-teacher quiz flags`" + `.
+With --all-topics the score is also broken down by topic.`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) > 0 && quizAllTopics {
+			fmt.Fprintln(os.Stderr, "Error: Give either a topic or --all-topics, not both")
+			os.Exit(1)
+		}
+		if len(args) == 0 && !quizAllTopics {
+			fmt.Println("Please specify a topic or --all-topics. For example:")
+			fmt.Println("  gocli-teacher quiz flags --count 5")
+			fmt.Println("\nAvailable topics:")
+			fmt.Println("  " + registry.TutorialNames())
+			return
+		}
+
+		var pool []quiz.Question
+		title := "Quiz: All Topics"
+		if quizAllTopics {
+			for _, t := range registry.Tutorials() {
+				pool = append(pool, registry.QuestionPool(t.ID)...)
+			}
+		} else {
+			tutorial, exists := registry.LookupTutorial(args[0])
+			if !exists {
+				fmt.Printf("Unknown quiz topic: %s\n", args[0])
+				fmt.Println("Available topics: " + registry.TutorialNames())
+				os.Exit(1)
+			}
+			pool = registry.QuestionPool(tutorial.ID)
+			title = "Quiz: " + tutorial.DisplayName()
+		}
+
+		if len(pool) == 0 {
+			fmt.Println("There are no questions for this topic yet.")
+			return
+		}
+		if quizCount < 1 {
+			fmt.Fprintln(os.Stderr, "Error: --count must be at least 1")
+			os.Exit(1)
+		}
+
+		utils.TestMode = testMode
+		if testMode {
+			fmt.Println("Running in non-interactive test mode")
+		}
+
+		questions := quiz.Pick(pool, quizCount)
+
+		utils.ClearScreen()
+		utils.PrintTitle(title)
+		results := quiz.Run(quiz.Quiz{
+			Intro:     fmt.Sprintf("%d questions drawn from a pool of %d:", len(questions), len(pool)),
+			Questions: questions,
+		})
+
+		utils.ClearScreen()
+		utils.PrintTitle(title)
+		fmt.Printf("You got %d out of %d questions correct! (%d%%)\n", results.Correct, results.Total, results.Score())
+
+		// Record the answers under the tutorial each question is about
+		tracker := loadTracker()
+		for _, topic := range registry.Tutorials() {
+			var topicResults quiz.Results
+			for _, result := range results.Questions {
+				if _, info, ok := registry.LookupQuestion(result.QuestionID); ok && info.ID == topic.ID {
+					topicResults.Questions = append(topicResults.Questions, result)
+					topicResults.Total++
+					if result.Correct {
+						topicResults.Correct++
+					}
+				}
+			}
+			if quizAllTopics && topicResults.Total > 0 {
+				fmt.Printf("  %s: %d of %d\n", topic.DisplayName(), topicResults.Correct, topicResults.Total)
+			}
+			recordQuizAttempt(tracker, topic.Info, "quiz", topicResults)
+		}
+		recordReview(results)
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(quizCmd)
+
+	quizCmd.Flags().IntVarP(&quizCount, "count", "n", 5, "Number of questions to ask")
+	quizCmd.Flags().BoolVar(&quizAllTopics, "all-topics", false, "Draw questions from every topic")
+	quizCmd.Flags().BoolVarP(&testMode, "test", "t", false, "Run in non-interactive test mode")
+}
@@ -0,0 +1,151 @@
+package cmd
+
+import (
+	"fmt"
+	"gocli-teacher/progress"
+	"gocli-teacher/quiz"
+	"gocli-teacher/registry"
+	"gocli-teacher/review"
+	"gocli-teacher/utils"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// reviewLimit caps the number of questions in one review session
+var reviewLimit int
+
+// reviewCmd represents the review command
+var reviewCmd = &cobra.Command{
+	Use:   "review",
+	Short: "Review quiz questions you missed or answered slowly",
+	Long: `Review resurfaces quiz questions you missed or took a long time
+to answer. Questions are scheduled with spaced repetition: each correct
+review pushes the next one further out, and a wrong answer brings the
+question back the next day.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.TestMode = testMode
+		if testMode {
+			fmt.Println("Running in non-interactive test mode")
+		}
+
+		deck, err := review.New()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: Failed to load review schedule: %s\n", err)
+			os.Exit(1)
+		}
+
+		now := time.Now()
+		due := deck.Due(now)
+		if len(due) == 0 {
+			if next, ok := deck.NextDue(); ok {
+				fmt.Printf("Nothing to review right now. The next review is due %s.\n", next.Format("Jan 02, 2006 15:04"))
+			} else {
+				fmt.Println("Nothing to review yet. Questions you miss in tutorials will show up here.")
+			}
+			return
+		}
+
+		// Leave out questions that were removed or whose content is not
+		// loaded, so that the count only includes questions that are asked
+		var questions []quiz.Question
+		var tutorials []registry.Info
+		for _, card := range due {
+			if question, tutorial, ok := registry.LookupQuestion(card.QuestionID); ok {
+				questions = append(questions, question)
+				tutorials = append(tutorials, tutorial)
+			}
+		}
+		if len(questions) == 0 {
+			fmt.Println("None of the questions due for review are available in the loaded content.")
+			return
+		}
+		if reviewLimit > 0 && len(questions) > reviewLimit {
+			questions = questions[:reviewLimit]
+		}
+
+		tracker := loadTracker()
+
+		var results quiz.Results
+		for i, question := range questions {
+			tutorial := tutorials[i]
+
+			utils.ClearScreen()
+			utils.PrintTitle(fmt.Sprintf("Review %d of %d", i+1, len(questions)))
+			fmt.Printf("From the %s tutorial\n", tutorial.DisplayName())
+
+			result := quiz.Ask(question, i+1)
+			deck.Record(result, time.Now())
+			if err := deck.Save(); err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: Could not save review schedule: %s\n", err)
+			}
+			recordQuizAttempt(tracker, tutorial, "review", quiz.Results{
+				Questions: []quiz.Result{result},
+				Total:     1,
+			})
+
+			results.Questions = append(results.Questions, result)
+			results.Total++
+			if result.Correct {
+				results.Correct++
+			}
+
+			utils.PressEnterToContinue()
+		}
+
+		fmt.Printf("\nReview complete: %d out of %d correct.\n", results.Correct, results.Total)
+		if next, ok := deck.NextDue(); ok {
+			fmt.Printf("Next review due %s.\n", next.Format("Jan 02, 2006 15:04"))
+		}
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(reviewCmd)
+
+	reviewCmd.Flags().IntVarP(&reviewLimit, "limit", "n", 10, "Maximum number of questions to review")
+	reviewCmd.Flags().BoolVarP(&testMode, "test", "t", false, "Run in non-interactive test mode")
+}
+
+// recordQuizAttempt stores the answers to a tutorial's quiz questions in the tracker
+func recordQuizAttempt(tracker *progress.Tracker, tutorial registry.Info, mode string, results quiz.Results) {
+	if tracker == nil || len(results.Questions) == 0 {
+		return
+	}
+
+	attempt := progress.QuizAttempt{
+		Tutorial: tutorial.ID,
+		Version:  tutorial.Version,
+		Mode:     mode,
+	}
+	for _, result := range results.Questions {
+		attempt.Answers = append(attempt.Answers, progress.QuizAnswer{
+			QuestionID: result.QuestionID,
+			Answer:     result.Answer,
+			Correct:    result.Correct,
+			Duration:   result.Duration,
+		})
+	}
+
+	if err := tracker.RecordQuizAttempt(attempt); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: Could not save quiz answers: %s\n", err)
+	}
+}
+
+// recordReview schedules the missed and slow questions of a quiz for review
+func recordReview(results quiz.Results) {
+	if len(results.Questions) == 0 {
+		return
+	}
+
+	deck, err := review.New()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: Could not load review schedule: %s\n", err)
+		return
+	}
+	if err := deck.RecordAll(results); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: Could not save review schedule: %s\n", err)
+	}
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"fmt"
+	"gocli-teacher/quiz"
 	"os"
 
 	"github.com/spf13/cobra"
@@ -36,9 +37,25 @@ Start by running one of the tutorial commands:
 
 var verbose bool
 
+// seed makes the order of quiz options repeatable when set
+var seed int64
+
+// Version is the gocli-teacher release, checked against the
+// min_tool_version of content packs
+var Version = "1.0.0"
+
 func init() {
+	RootCmd.Version = Version
+
 	// Add global flags
 	RootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "Enable verbose output")
+	RootCmd.PersistentFlags().Int64Var(&seed, "seed", 0, "Seed for shuffling quiz options, for repeatable runs")
+
+	cobra.OnInitialize(func() {
+		if seed != 0 {
+			quiz.Seed(seed)
+		}
+	})
 }
 
 // ExecuteWithArgs executes the root command with the given arguments for testing
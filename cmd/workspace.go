@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"fmt"
+	"gocli-teacher/registry"
+	"gocli-teacher/utils"
+	"gocli-teacher/workspace"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+)
+
+// workspaceRoot is the workspace root given on the command line
+var workspaceRoot string
+
+// workspaceCmd shows where exercise programs are written
+var workspaceCmd = &cobra.Command{
+	Use:   "workspace",
+	Short: "Show where your exercise programs are",
+	Long: `Every exercise writes your program to a subdirectory of one workspace
+root, ~/` + workspace.DefaultName + ` unless you choose another one with
+'gocli-teacher workspace set <dir>', the ` + workspace.EnvVar + `
+environment variable or the --workspace flag.
+
+This command shows the root and the directory of each exercise you have
+started.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		root := mustWorkspaceRoot()
+		fmt.Printf("Workspace root: %s\n", root)
+
+		tracker := loadTracker()
+		if tracker == nil {
+			return
+		}
+		started := false
+		for _, e := range registry.Exercises() {
+			if dir, ok := tracker.Workspace(e.ID); ok {
+				if !started {
+					fmt.Println("\nExercises:")
+					started = true
+				}
+				fmt.Printf("  %-18s %s\n", e.DisplayName(), dir)
+			}
+		}
+	},
+}
+
+// workspaceSetCmd saves the workspace root
+var workspaceSetCmd = &cobra.Command{
+	Use:   "set <dir>",
+	Short: "Choose the directory exercise programs are written to",
+	Long: `Set saves the workspace root for later runs. Exercises you have already
+started stay where they are; new ones are written to the new root.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := workspace.SetRoot(args[0]); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+			os.Exit(1)
+		}
+		root, _ := filepath.Abs(args[0])
+		fmt.Printf("Exercise programs will be written to %s\n", root)
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(workspaceCmd)
+	workspaceCmd.AddCommand(workspaceSetCmd)
+
+	RootCmd.PersistentFlags().StringVar(&workspaceRoot, "workspace", "",
+		"Directory exercise programs are written to (also "+workspace.EnvVar+")")
+}
+
+// mustWorkspaceRoot returns the workspace root, or exits
+func mustWorkspaceRoot() string {
+	root, err := workspace.Root(workspaceRoot)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: Failed to find the workspace: %s\n", err)
+		os.Exit(1)
+	}
+	return root
+}
+
+// exerciseDir returns the directory of the learner's program for an
+// exercise: where they started it if that is recorded, or its subdirectory
+// of the workspace root. A program left in the current directory by an
+// earlier version is used while the workspace has none.
+func exerciseDir(exercise registry.Exercise) string {
+	if workspaceRoot != "" {
+		return filepath.Join(mustWorkspaceRoot(), exercise.Directory)
+	}
+	if tracker := loadTracker(); tracker != nil {
+		if dir, ok := tracker.Workspace(exercise.ID); ok {
+			return dir
+		}
+	}
+
+	dir := filepath.Join(mustWorkspaceRoot(), exercise.Directory)
+	if legacy, ok := workspace.Legacy(exercise.Directory); ok && legacy != dir {
+		if _, err := os.Stat(dir); os.IsNotExist(err) {
+			return legacy
+		}
+	}
+	return dir
+}
+
+// adoptLegacy asks a learner whose program exerciseDir found in the current
+// directory whether to keep it there or move it into the workspace root,
+// and returns the directory to use
+func adoptLegacy(exercise registry.Exercise, dir string) string {
+	legacy, ok := workspace.Legacy(exercise.Directory)
+	target := filepath.Join(mustWorkspaceRoot(), exercise.Directory)
+	if !ok || legacy != dir || dir == target {
+		return dir
+	}
+
+	fmt.Printf("\nYour program for this exercise is in %s, where earlier\n", dir)
+	fmt.Printf("versions of gocli-teacher wrote it. Exercises now go in %s.\n\n", filepath.Dir(target))
+	options := []string{
+		"Keep working on it where it is",
+		"Move it to " + target,
+	}
+	if utils.AskChoice("What would you like to do?", options, 0) == 0 {
+		return dir
+	}
+	if err := workspace.Move(dir, target); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: Could not move your program: %s\n", err)
+		return dir
+	}
+	fmt.Printf("\nMoved your program to %s\n", target)
+	return target
+}
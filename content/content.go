@@ -0,0 +1,57 @@
+package content
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// EnvVar names the environment variable listing extra content directories,
+// separated like PATH entries
+const EnvVar = "GOCLI_TEACHER_CONTENT_DIR"
+
+// embedded holds the content that ships with the tool
+//
+//go:embed tutorials/*.yaml quizzes/*.yaml exercises/*.yaml paths/*.yaml
+var embedded embed.FS
+
+// layers holds every content source, lowest priority first
+var layers = []fs.FS{embedded}
+
+// Builtin returns the content that ships with the tool
+func Builtin() fs.FS {
+	return embedded
+}
+
+// FS returns the combined content. Files in later layers replace
+// files with the same path in earlier ones.
+func FS() fs.FS {
+	return newOverlay(layers)
+}
+
+// AddDir layers a content directory on top of the current content
+func AddDir(dir string) error {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return fmt.Errorf("failed to open content directory: %w", err)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("content directory %s is not a directory", dir)
+	}
+
+	layers = append(layers, os.DirFS(dir))
+	return nil
+}
+
+// DirsFromEnv returns the content directories listed in EnvVar
+func DirsFromEnv() []string {
+	var dirs []string
+	for _, dir := range filepath.SplitList(os.Getenv(EnvVar)) {
+		if dir != "" {
+			dirs = append(dirs, dir)
+		}
+	}
+	return dirs
+}
@@ -0,0 +1,72 @@
+package content
+
+import (
+	"errors"
+	"io/fs"
+	"sort"
+)
+
+// overlay merges several file systems. Files are looked up from the
+// last layer to the first, and directory listings are combined.
+type overlay struct {
+	layers []fs.FS
+}
+
+// newOverlay creates an overlay of the given layers, lowest priority first
+func newOverlay(layers []fs.FS) *overlay {
+	return &overlay{layers: append([]fs.FS(nil), layers...)}
+}
+
+// Open opens the named file from the highest priority layer that has it
+func (o *overlay) Open(name string) (fs.File, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+
+	for i := len(o.layers) - 1; i >= 0; i-- {
+		f, err := o.layers[i].Open(name)
+		if err == nil {
+			return f, nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+	}
+
+	return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+}
+
+// ReadDir lists the named directory across all layers
+func (o *overlay) ReadDir(name string) ([]fs.DirEntry, error) {
+	entries := make(map[string]fs.DirEntry)
+	found := false
+
+	for _, layer := range o.layers {
+		list, err := fs.ReadDir(layer, name)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return nil, err
+		}
+
+		found = true
+		for _, entry := range list {
+			entries[entry.Name()] = entry
+		}
+	}
+
+	if !found {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrNotExist}
+	}
+
+	result := make([]fs.DirEntry, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, entry)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name() < result[j].Name()
+	})
+
+	return result, nil
+}
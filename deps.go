@@ -0,0 +1,18 @@
+//go:build deps
+
+// Learner programs are built with gocli-teacher's go.mod, which therefore
+// requires the packages exercises and lessons use even where gocli-teacher
+// itself doesn't. Importing them here keeps them there through go mod tidy
+// and puts them in the directory go mod vendor writes, which is bundled for
+// machines without network access.
+
+package main
+
+import (
+	_ "github.com/AlecAivazis/survey/v2"
+	_ "github.com/olekukonko/tablewriter"
+	_ "github.com/olekukonko/tablewriter/renderer"
+	_ "github.com/olekukonko/tablewriter/tw"
+	_ "github.com/schollz/progressbar/v3"
+	_ "github.com/spf13/cobra"
+)
@@ -0,0 +1,275 @@
+// Package diff compares Go source files line by line, optionally ignoring
+// whitespace, comments or formatting, and prints the differences.
+package diff
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/scanner"
+	"go/token"
+	"strings"
+	"unicode"
+)
+
+// File is a named source file
+type File struct {
+	Name   string
+	Source string
+}
+
+// Options say what a comparison ignores
+type Options struct {
+	IgnoreWhitespace bool // Compare lines without their spaces and tabs, and skip blank lines
+	IgnoreComments   bool // Leave comments out
+	Normalize        bool // Compare the files as printed from their syntax trees, which ignores formatting and comments
+	Context          int  // Unchanged lines shown around each change
+}
+
+// Op says whether a line is in both files or only one of them
+type Op int
+
+const (
+	Equal  Op = iota // The line is in both files
+	Delete           // The line is only in the first file
+	Insert           // The line is only in the second file
+)
+
+// Line is a line of a diff
+type Line struct {
+	Op         Op
+	A, B       string // Text of the line in each file, "" where it is absent
+	ANum, BNum int    // Line numbers in each file, 0 where it is absent
+}
+
+// Hunk is a run of changed lines with the unchanged lines around them
+type Hunk struct {
+	AStart, ALen int // First line and number of lines in the first file
+	BStart, BLen int // First line and number of lines in the second file
+	Lines        []Line
+}
+
+// line is a line of a file being compared
+type line struct {
+	text string
+	num  int
+	key  string // What is compared
+}
+
+// Compare returns the hunks in which a and b differ, or none if they are
+// the same with opts
+func Compare(a, b File, opts Options) ([]Hunk, error) {
+	linesA, err := prepare(a, opts)
+	if err != nil {
+		return nil, err
+	}
+	linesB, err := prepare(b, opts)
+	if err != nil {
+		return nil, err
+	}
+	return hunks(edits(linesA, linesB), opts.Context), nil
+}
+
+// prepare splits a file into the lines to compare
+func prepare(f File, opts Options) ([]line, error) {
+	source := f.Source
+	switch {
+	case opts.Normalize:
+		normalized, err := normalize(f)
+		if err != nil {
+			return nil, err
+		}
+		source = normalized
+	case opts.IgnoreComments:
+		source = stripComments(source)
+	}
+
+	// An empty file has no lines, rather than one empty line
+	if source == "" {
+		return nil, nil
+	}
+
+	var lines []line
+	original := strings.Split(f.Source, "\n")
+	for i, text := range strings.Split(strings.TrimSuffix(source, "\n"), "\n") {
+		text = strings.TrimRight(text, "\r")
+		if opts.IgnoreComments && !opts.Normalize && text != strings.TrimRight(original[i], "\r") {
+			// Drop what is left of lines that held comments, unless it is code
+			text = strings.TrimRight(text, " \t")
+			if text == "" {
+				continue
+			}
+		}
+		key := text
+		if opts.IgnoreWhitespace {
+			key = strings.Map(func(r rune) rune {
+				if unicode.IsSpace(r) {
+					return -1
+				}
+				return r
+			}, text)
+			if key == "" {
+				continue
+			}
+		}
+		lines = append(lines, line{text: text, num: i + 1, key: key})
+	}
+	return lines, nil
+}
+
+// normalize prints the syntax tree of a file without its comments and
+// without the positions that keep the original layout, so that files that
+// only differ in formatting print the same
+func normalize(f File) (string, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, f.Name, f.Source, parser.SkipObjectResolution)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse %s: %w", f.Name, err)
+	}
+	ast.SortImports(fset, file)
+
+	var buf bytes.Buffer
+	config := printer.Config{Mode: printer.UseSpaces | printer.TabIndent, Tabwidth: 8}
+	if err := config.Fprint(&buf, token.NewFileSet(), file); err != nil {
+		return "", fmt.Errorf("failed to print %s: %w", f.Name, err)
+	}
+	return buf.String(), nil
+}
+
+// stripComments blanks out the comments of source, keeping its lines where
+// they are
+func stripComments(source string) string {
+	src := []byte(source)
+	fset := token.NewFileSet()
+	file := fset.AddFile("", fset.Base(), len(src))
+	var s scanner.Scanner
+	s.Init(file, src, nil, scanner.ScanComments)
+
+	for {
+		pos, tok, lit := s.Scan()
+		if tok == token.EOF {
+			break
+		}
+		if tok != token.COMMENT {
+			continue
+		}
+		start := file.Offset(pos)
+		for i := start; i < start+len(lit) && i < len(src); i++ {
+			if src[i] != '\n' {
+				src[i] = ' '
+			}
+		}
+	}
+	return string(src)
+}
+
+// edits returns the lines of a longest common subsequence of a and b, with
+// the lines only in one of them between
+func edits(a, b []line) []Line {
+	// lengths[i][j] is the length of the longest common subsequence of a[i:]
+	// and b[j:]
+	lengths := make([][]int, len(a)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i].key == b[j].key {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else {
+				lengths[i][j] = max(lengths[i+1][j], lengths[i][j+1])
+			}
+		}
+	}
+
+	var lines []Line
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i].key == b[j].key:
+			lines = append(lines, Line{Op: Equal, A: a[i].text, B: b[j].text, ANum: a[i].num, BNum: b[j].num})
+			i++
+			j++
+		case j == len(b) || (i < len(a) && lengths[i+1][j] >= lengths[i][j+1]):
+			lines = append(lines, Line{Op: Delete, A: a[i].text, ANum: a[i].num})
+			i++
+		default:
+			lines = append(lines, Line{Op: Insert, B: b[j].text, BNum: b[j].num})
+			j++
+		}
+	}
+	return lines
+}
+
+// hunks groups the changed lines with up to context unchanged lines on each
+// side, merging groups that would overlap
+func hunks(lines []Line, context int) []Hunk {
+	var result []Hunk
+	for i := 0; i < len(lines); {
+		if lines[i].Op == Equal {
+			i++
+			continue
+		}
+
+		// Take in changes until the unchanged lines after them are too many
+		// to be shown on both sides of a gap
+		start, end := max(i-context, 0), i
+		for {
+			for end < len(lines) && lines[end].Op != Equal {
+				end++
+			}
+			next := end
+			for next < len(lines) && lines[next].Op == Equal {
+				next++
+			}
+			if next == len(lines) || next-end > 2*context {
+				end = min(end+context, len(lines))
+				break
+			}
+			end = next
+		}
+		result = append(result, newHunk(lines[:start], lines[start:end]))
+		i = end
+	}
+	return result
+}
+
+// newHunk returns the hunk of lines, which come after before. A hunk that
+// holds no lines of a file starts, in that file, at the line before it.
+func newHunk(before, lines []Line) Hunk {
+	h := Hunk{Lines: lines}
+	for _, l := range before {
+		h.AStart = max(h.AStart, l.ANum)
+		h.BStart = max(h.BStart, l.BNum)
+	}
+	for _, l := range lines {
+		if l.ANum > 0 {
+			if h.ALen == 0 {
+				h.AStart = l.ANum
+			}
+			h.ALen++
+		}
+		if l.BNum > 0 {
+			if h.BLen == 0 {
+				h.BStart = l.BNum
+			}
+			h.BLen++
+		}
+	}
+	return h
+}
+
+// Header returns the @@ line that starts the hunk in a unified diff
+func (h Hunk) Header() string {
+	return fmt.Sprintf("@@ -%s +%s @@", span(h.AStart, h.ALen), span(h.BStart, h.BLen))
+}
+
+// span formats where a hunk is in one file
+func span(start, length int) string {
+	if length == 1 {
+		return fmt.Sprint(start)
+	}
+	return fmt.Sprintf("%d,%d", start, length)
+}
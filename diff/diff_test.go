@@ -0,0 +1,263 @@
+package diff
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// numbered returns a file of one line per name
+func numbered(names ...string) string {
+	return strings.Join(names, "\n") + "\n"
+}
+
+// nine is a file of the lines 1 to 9
+var nine = numbered("1", "2", "3", "4", "5", "6", "7", "8", "9")
+
+// render formats hunks as a unified diff without the file names
+func render(hunks []Hunk) string {
+	var buf bytes.Buffer
+	Unified(&buf, "a", "b", hunks, false)
+	return strings.TrimPrefix(buf.String(), "--- a\n+++ b\n")
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name    string
+		a, b    string
+		context int
+		want    string
+	}{
+		{
+			name: "identical",
+			a:    nine,
+			b:    nine,
+		},
+		{
+			name: "both empty",
+		},
+		{
+			name:    "added to an empty file",
+			b:       "x\n",
+			context: 3,
+			want:    "@@ -0,0 +1 @@\n+x\n",
+		},
+		{
+			name:    "emptied",
+			a:       "x\ny\n",
+			context: 3,
+			want:    "@@ -1,2 +0,0 @@\n-x\n-y\n",
+		},
+		{
+			name:    "one change",
+			a:       nine,
+			b:       numbered("1", "2", "3", "4", "X", "6", "7", "8", "9"),
+			context: 1,
+			want:    "@@ -4,3 +4,3 @@\n 4\n-5\n+X\n 6\n",
+		},
+		{
+			name:    "adjacent changes share a hunk",
+			a:       nine,
+			b:       numbered("1", "2", "X", "4", "Y", "6", "7", "8", "9"),
+			context: 1,
+			want:    "@@ -2,5 +2,5 @@\n 2\n-3\n+X\n 4\n-5\n+Y\n 6\n",
+		},
+		{
+			name:    "overlapping context is merged",
+			a:       nine,
+			b:       numbered("1", "2", "X", "4", "5", "Y", "7", "8", "9"),
+			context: 1,
+			want:    "@@ -2,6 +2,6 @@\n 2\n-3\n+X\n 4\n 5\n-6\n+Y\n 7\n",
+		},
+		{
+			name:    "distant changes are separate hunks",
+			a:       nine,
+			b:       numbered("1", "2", "X", "4", "5", "6", "Y", "8", "9"),
+			context: 1,
+			want:    "@@ -2,3 +2,3 @@\n 2\n-3\n+X\n 4\n@@ -6,3 +6,3 @@\n 6\n-7\n+Y\n 8\n",
+		},
+		{
+			name: "no context",
+			a:    nine,
+			b:    numbered("1", "2", "4", "5", "6", "7", "8", "9"),
+			want: "@@ -3 +2,0 @@\n-3\n",
+		},
+		{
+			name:    "changes at both ends",
+			a:       nine,
+			b:       numbered("0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10"),
+			context: 2,
+			want:    "@@ -1,2 +1,3 @@\n+0\n 1\n 2\n@@ -8,2 +9,3 @@\n 8\n 9\n+10\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hunks, err := Compare(File{Name: "a", Source: tt.a}, File{Name: "b", Source: tt.b}, Options{Context: tt.context})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := render(hunks); got != tt.want {
+				t.Errorf("got\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompareOptions(t *testing.T) {
+	const program = `package main
+
+import (
+	"fmt"
+	"os"
+)
+
+// main greets
+func main() {
+	name := os.Args[1] // who to greet
+	fmt.Println("Hello,", name)
+}
+`
+	tests := []struct {
+		name  string
+		other string
+		opts  Options
+		same  bool // Whether the files compare equal with opts
+	}{
+		{
+			name:  "whitespace differs",
+			other: strings.ReplaceAll(strings.Replace(program, "\n\n", "\n\n\n", 1), "\t", "    "),
+			same:  false,
+		},
+		{
+			name:  "whitespace ignored",
+			other: strings.ReplaceAll(strings.Replace(program, "\n\n", "\n\n\n", 1), "\t", "    "),
+			opts:  Options{IgnoreWhitespace: true},
+			same:  true,
+		},
+		{
+			name:  "comments differ",
+			other: strings.Replace(strings.Replace(program, "// main greets\n", "", 1), " // who to greet", "", 1),
+			same:  false,
+		},
+		{
+			name:  "comments ignored",
+			other: strings.Replace(strings.Replace(program, "// main greets\n", "/* the\nprogram */\n", 1), " // who to greet", "", 1),
+			opts:  Options{IgnoreComments: true},
+			same:  true,
+		},
+		{
+			name:  "code differs with comments ignored",
+			other: strings.Replace(program, "Hello,", "Hi,", 1),
+			opts:  Options{IgnoreComments: true},
+			same:  false,
+		},
+		{
+			name:  "formatting and import order ignored with --ast",
+			other: "package main\nimport (\"os\"; \"fmt\")\nfunc main() { name := os.Args[1]\n fmt.Println(\"Hello,\", name) }\n",
+			opts:  Options{Normalize: true},
+			same:  true,
+		},
+		{
+			name:  "code differs with --ast",
+			other: strings.Replace(program, "os.Args[1]", "os.Args[2]", 1),
+			opts:  Options{Normalize: true},
+			same:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hunks, err := Compare(File{Name: "a", Source: program}, File{Name: "b", Source: tt.other}, tt.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if same := len(hunks) == 0; same != tt.same {
+				t.Errorf("same = %v, want %v; diff:\n%s", same, tt.same, render(hunks))
+			}
+		})
+	}
+}
+
+func TestCompareNormalizeSyntaxError(t *testing.T) {
+	_, err := Compare(File{Name: "main.go", Source: "package main\nfunc main() {\n"}, File{Name: "solution", Source: "package main\n"}, Options{Normalize: true})
+	if err == nil || !strings.Contains(err.Error(), "main.go") {
+		t.Errorf("got error %v, want one naming main.go", err)
+	}
+}
+
+func TestCompareKeepsLineNumbers(t *testing.T) {
+	// Skipped blank lines and comments still count towards line numbers
+	a := "x := 1\n\n// note\ny := 2\n"
+	b := "x := 1\ny := 3\n"
+	hunks, err := Compare(File{Source: a}, File{Source: b}, Options{IgnoreWhitespace: true, IgnoreComments: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hunks) != 1 {
+		t.Fatalf("got %d hunks, want 1", len(hunks))
+	}
+	var deleted, inserted Line
+	for _, l := range hunks[0].Lines {
+		switch l.Op {
+		case Delete:
+			deleted = l
+		case Insert:
+			inserted = l
+		}
+	}
+	if deleted.ANum != 4 || inserted.BNum != 2 {
+		t.Errorf("changed lines are %d and %d, want 4 and 2", deleted.ANum, inserted.BNum)
+	}
+}
+
+func TestSideBySide(t *testing.T) {
+	a := numbered("same", "\told", "gone", "same again")
+	b := numbered("same", "\tnew", "same again", "added with a line too long to fit in its column")
+	hunks, err := Compare(File{Source: a}, File{Source: b}, Options{Context: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	SideBySide(&buf, "main.go", "solution", hunks, 53, false) // Columns of 25
+	want := strings.Join([]string{
+		"main.go                     solution",
+		"@@ -1,4 +1,4 @@",
+		"same                        same",
+		"    old                   |     new",
+		"gone                      <",
+		"same again                  same again",
+		"                          > added with a line too lo…",
+	}, "\n") + "\n"
+	if got := buf.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestSideBySideNarrow(t *testing.T) {
+	hunks, err := Compare(File{Source: "a\n"}, File{Source: "b\n"}, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	SideBySide(&buf, "main.go", "solution", hunks, 10, false)
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if got := lines[len(lines)-1]; !strings.HasPrefix(got, "a"+strings.Repeat(" ", minColumn-1)+" | b") {
+		t.Errorf("got %q, want columns of %d characters", got, minColumn)
+	}
+}
+
+func TestUnifiedColor(t *testing.T) {
+	hunks, err := Compare(File{Source: "a\n"}, File{Source: "b\n"}, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	Unified(&buf, "x", "y", hunks, true)
+	for _, want := range []string{colorRed + "-a" + colorReset, colorGreen + "+b" + colorReset, colorCyan + "@@ -1 +1 @@" + colorReset} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("output %q does not contain %q", buf.String(), want)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package diff
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// Escape sequences that color the parts of a diff
+const (
+	colorBold  = "\x1b[1m"
+	colorRed   = "\x1b[31m"
+	colorGreen = "\x1b[32m"
+	colorCyan  = "\x1b[36m"
+	colorReset = "\x1b[0m"
+)
+
+// Layout of side-by-side diffs
+const (
+	tabWidth    = 4  // Columns a tab takes up
+	minColumn   = 20 // Narrowest each side gets, however narrow the terminal
+	gutterWidth = 3  // Columns between the sides
+)
+
+// painter colors text when color is on
+type painter bool
+
+func (p painter) paint(color, text string) string {
+	if !p {
+		return text
+	}
+	return color + text + colorReset
+}
+
+// Unified writes the hunks as a unified diff of a and b
+func Unified(w io.Writer, a, b string, hunks []Hunk, color bool) {
+	p := painter(color)
+	fmt.Fprintln(w, p.paint(colorBold, "--- "+a))
+	fmt.Fprintln(w, p.paint(colorBold, "+++ "+b))
+	for _, h := range hunks {
+		fmt.Fprintln(w, p.paint(colorCyan, h.Header()))
+		for _, l := range h.Lines {
+			switch l.Op {
+			case Equal:
+				fmt.Fprintln(w, " "+l.A)
+			case Delete:
+				fmt.Fprintln(w, p.paint(colorRed, "-"+l.A))
+			case Insert:
+				fmt.Fprintln(w, p.paint(colorGreen, "+"+l.B))
+			}
+		}
+	}
+}
+
+// SideBySide writes the hunks in two columns that fit in width, a on the
+// left and b on the right. The gutter between them marks lines that are
+// only on the left with <, only on the right with > and changed with |.
+func SideBySide(w io.Writer, a, b string, hunks []Hunk, width int, color bool) {
+	p := painter(color)
+	column := max((width-gutterWidth)/2, minColumn)
+	row := func(left, gutter, right string, leftColor, rightColor string) {
+		left = fit(left, column)
+		if leftColor != "" {
+			left = p.paint(leftColor, left)
+		}
+		right = strings.TrimRight(fit(right, column), " ")
+		if rightColor != "" {
+			right = p.paint(rightColor, right)
+		}
+		fmt.Fprintln(w, strings.TrimRight(left+" "+gutter+" "+right, " "))
+	}
+
+	row(a, " ", b, colorBold, colorBold)
+	for _, h := range hunks {
+		fmt.Fprintln(w, p.paint(colorCyan, h.Header()))
+		for i := 0; i < len(h.Lines); {
+			if h.Lines[i].Op == Equal {
+				row(h.Lines[i].A, " ", h.Lines[i].B, "", "")
+				i++
+				continue
+			}
+
+			// Pair the lines removed from a with those added in b
+			var deleted, inserted []string
+			for ; i < len(h.Lines) && h.Lines[i].Op != Equal; i++ {
+				if h.Lines[i].Op == Delete {
+					deleted = append(deleted, h.Lines[i].A)
+				} else {
+					inserted = append(inserted, h.Lines[i].B)
+				}
+			}
+			for j := 0; j < max(len(deleted), len(inserted)); j++ {
+				switch {
+				case j >= len(inserted):
+					row(deleted[j], "<", "", colorRed, "")
+				case j >= len(deleted):
+					row("", ">", inserted[j], "", colorGreen)
+				default:
+					row(deleted[j], "|", inserted[j], colorRed, colorGreen)
+				}
+			}
+		}
+	}
+}
+
+// fit expands the tabs in text and pads or cuts it to width characters
+func fit(text string, width int) string {
+	var sb strings.Builder
+	n := 0
+	for _, r := range text {
+		if r == '\t' {
+			spaces := tabWidth - n%tabWidth
+			sb.WriteString(strings.Repeat(" ", spaces))
+			n += spaces
+		} else {
+			sb.WriteRune(r)
+			n++
+		}
+	}
+	expanded := sb.String()
+	if n > width {
+		runes := []rune(expanded)
+		return string(runes[:width-1]) + "…"
+	}
+	return expanded + strings.Repeat(" ", width-utf8.RuneCountInString(expanded))
+}
@@ -0,0 +1,194 @@
+package exercises
+
+import (
+        "errors"
+        "fmt"
+        "gocli-teacher/grader"
+        "gocli-teacher/lessons"
+        "gocli-teacher/registry"
+        "gocli-teacher/utils"
+        "io/fs"
+        "path/filepath"
+
+        "gopkg.in/yaml.v3"
+)
+
+// Definition describes an exercise loaded from a content file
+type Definition struct {
+        ID            string         `yaml:"id"`
+        Name          string         `yaml:"name"`    // Name used on the command line, defaults to the ID
+        Aliases       []string       `yaml:"aliases"` // Other names accepted on the command line
+        Title         string         `yaml:"title"`
+        Description   string         `yaml:"description"`
+        Difficulty    string         `yaml:"difficulty"`
+        Order         int            `yaml:"order"`         // Position in exercise listings
+        Prerequisites []string       `yaml:"prerequisites"` // IDs of tutorials or exercises to finish first
+        Directory     string         `yaml:"directory"`     // Subdirectory of the workspace the template is written to, defaults to <id>_exercise
+        Pages         []lessons.Page `yaml:"pages"`         // Shown before the template
+        Hints         []lessons.Page `yaml:"hints"`         // Shown after the template
+        Template      string         `yaml:"template"`
+        Note          string         `yaml:"note"`          // Shown under the template, such as the packages it needs
+        Solution      string         `yaml:"solution"`
+        grader.Spec   `yaml:",inline"` // Test cases and rubric the learner's program is graded with
+        Closing       string         `yaml:"closing"`
+}
+
+// LoadDefinition reads and parses an exercise definition from the given file system
+func LoadDefinition(fsys fs.FS, path string) (*Definition, error) {
+        data, err := fs.ReadFile(fsys, path)
+        if err != nil {
+                return nil, fmt.Errorf("failed to read exercise: %w", err)
+        }
+
+        var def Definition
+        if err := yaml.Unmarshal(data, &def); err != nil {
+                return nil, fmt.Errorf("%s: failed to parse exercise: %w", path, err)
+        }
+
+        if def.ID == "" {
+                return nil, fmt.Errorf("%s: exercise is missing an id", path)
+        }
+        if def.Title == "" {
+                return nil, fmt.Errorf("%s: exercise %s is missing a title", path, def.ID)
+        }
+        if def.Template == "" {
+                return nil, fmt.Errorf("%s: exercise %s is missing a template", path, def.ID)
+        }
+        if err := def.Spec.Prepare(); err != nil {
+                return nil, fmt.Errorf("%s: exercise %s: %w", path, def.ID, err)
+        }
+        if def.Directory == "" {
+                def.Directory = def.ID + "_exercise"
+        }
+        if !filepath.IsLocal(def.Directory) {
+                return nil, fmt.Errorf("%s: exercise %s: directory %q is not inside the workspace", path, def.ID, def.Directory)
+        }
+
+        return &def, nil
+}
+
+// Register loads every exercise definition in the exercises directory of
+// fsys and adds it to the registry. Definitions that fail to load are
+// skipped and reported in the returned error.
+func Register(fsys fs.FS) error {
+        paths, err := fs.Glob(fsys, "exercises/*.yaml")
+        if err != nil {
+                return fmt.Errorf("failed to list exercises: %w", err)
+        }
+
+        var errs []error
+        for _, path := range paths {
+                def, err := LoadDefinition(fsys, path)
+                if err != nil {
+                        errs = append(errs, err)
+                        continue
+                }
+
+                err = registry.RegisterExercise(registry.Exercise{
+                        Info: registry.Info{
+                                ID:            def.ID,
+                                Name:          def.Name,
+                                Aliases:       def.Aliases,
+                                Description:   def.Description,
+                                Difficulty:    def.Difficulty,
+                                Order:         def.Order,
+                                Prerequisites: def.Prerequisites,
+                        },
+                        Directory: def.Directory,
+                        Solution:  def.Solution,
+                        Spec:      def.Spec,
+                        Run: func(dir string, graded func(grader.Report)) (bool, int) {
+                                return RunDefinition(def, dir, graded)
+                        },
+                })
+                if err != nil {
+                        errs = append(errs, fmt.Errorf("%s: %w", path, err))
+                }
+        }
+
+        return errors.Join(errs...)
+}
+
+// RunDefinition walks the learner through an exercise loaded from content,
+// with their program in dir, passing graded the report of every grading
+func RunDefinition(def *Definition, dir string, graded func(grader.Report)) (bool, int) {
+        for _, page := range def.Pages {
+                lessons.ShowPage(def.Title, page)
+                utils.PressEnterToContinue()
+        }
+
+        utils.ClearScreen()
+        utils.PrintTitle(def.Title)
+
+        fmt.Println("Here's a template to get you started:")
+        fmt.Println("")
+        utils.PrintCodeWithLineNumbers(def.Template)
+        fmt.Print(def.Note)
+
+        // Write the template to the exercise's workspace
+        if !setupExercise(dir, def.Template) {
+                return false, 0
+        }
+
+        utils.PressEnterToContinue()
+
+        for _, page := range def.Hints {
+                lessons.ShowPage(def.Title, page)
+                utils.PressEnterToContinue()
+        }
+
+        var report grader.Report
+        if !def.Spec.Empty() {
+                utils.ClearScreen()
+                utils.PrintTitle(def.Title)
+
+                fmt.Println("Testing Your Solution:")
+                fmt.Println("")
+                fmt.Println("Once you've completed the exercise, you can test it with these commands:")
+                fmt.Println("")
+                fmt.Print(grader.FormatCases(def.Cases))
+
+                utils.PressEnterToContinue()
+
+                // Build and test the learner's program
+                report = gradeExercise(def.Title, dir, def.Spec, graded)
+        }
+
+        if def.Solution != "" {
+                utils.ClearScreen()
+                utils.PrintTitle(def.Title)
+
+                offerSolutionDiff(dir, def.Solution, report)
+
+                fmt.Println("Need the solution?")
+                if utils.AskYesNo("Would you like to see the solution?") {
+                        utils.ClearScreen()
+                        utils.PrintTitle(def.Title + " - Solution")
+
+                        fmt.Println("Here's one way to solve the exercise:")
+                        fmt.Println("")
+                        utils.PrintCodeWithLineNumbers(def.Solution)
+
+                        // Create the solution file in a directory of its own, so that
+                        // go run . still builds main.go alone
+                        solutionFile, err := utils.CreateExerciseFile(filepath.Join(dir, "solution"), "main.go", def.Solution)
+                        if err != nil {
+                                fmt.Printf("Error creating solution file: %v\n", err)
+                        } else {
+                                fmt.Printf("\nI've saved the solution to %s\n", solutionFile)
+                                fmt.Println("Run it with 'go run ./solution'")
+                        }
+
+                        utils.PressEnterToContinue()
+                }
+        }
+
+        utils.ClearScreen()
+        utils.PrintTitle(def.Title)
+        fmt.Print(def.Closing)
+
+        utils.PressEnterToContinue()
+
+        // Exercises without test cases cannot be completed
+        return report.Completed(), report.Score()
+}
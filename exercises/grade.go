@@ -0,0 +1,82 @@
+package exercises
+
+import (
+        "fmt"
+        "gocli-teacher/diff"
+        "gocli-teacher/grader"
+        "gocli-teacher/utils"
+        "os"
+        "path/filepath"
+)
+
+// gradeExercise builds the learner's main.go in dir and grades it against
+// spec. The learner can fix their code and grade it again until everything
+// passes or they stop. Every report is passed to graded.
+func gradeExercise(title, dir string, spec grader.Spec, graded func(grader.Report)) grader.Report {
+        for {
+                utils.ClearScreen()
+                utils.PrintTitle(title + " - Grading")
+
+                fmt.Printf("Finish editing %s, then press Enter to grade it.\n", filepath.Join(dir, "main.go"))
+                utils.PressEnterToContinue()
+
+                // Point out mistakes while the compiler has yet to run
+                fmt.Println("")
+                diagnostics, err := grader.Diagnose(dir)
+                if err != nil {
+                        fmt.Printf("Error grading your program: %v\n", err)
+                        return grader.Report{}
+                }
+                fmt.Print(grader.FormatDiagnostics(diagnostics))
+
+                fmt.Println("Building and testing your program...")
+                fmt.Println("")
+                report, err := grader.Grade(dir, spec)
+                if err != nil {
+                        fmt.Printf("Error grading your program: %v\n", err)
+                        return report
+                }
+                fmt.Print(grader.FormatReport(report))
+                graded(report)
+
+                if report.Completed() || utils.TestMode {
+                        utils.PressEnterToContinue()
+                        return report
+                }
+                if !utils.AskYesNo("\nWould you like to fix your code and grade it again?") {
+                        return report
+                }
+        }
+}
+
+// offerSolutionDiff lets a learner whose program did not pass compare it
+// with the solution, before they choose whether to see all of it
+func offerSolutionDiff(dir, solution string, report grader.Report) {
+        if report.Completed() {
+                return
+        }
+        source, err := os.ReadFile(filepath.Join(dir, "main.go"))
+        if err != nil {
+                return
+        }
+        if !utils.AskYesNo("Would you like to compare your program with the solution?") {
+                return
+        }
+
+        yours := diff.File{Name: "main.go", Source: string(source)}
+        reference := diff.File{Name: "solution", Source: solution}
+        hunks, err := diff.Compare(yours, reference, diff.Options{IgnoreWhitespace: true, Context: 3})
+        if err != nil {
+                fmt.Printf("Error comparing your program: %v\n", err)
+                return
+        }
+        fmt.Println("")
+        if len(hunks) == 0 {
+                fmt.Println("Your program matches the solution apart from whitespace.")
+        } else {
+                diff.Unified(os.Stdout, yours.Name, reference.Name, hunks, utils.ColorEnabled(os.Stdout))
+        }
+        fmt.Println("\nFor other ways to compare them, see 'gocli-teacher exercise diff --help'.")
+        utils.PressEnterToContinue()
+        fmt.Println("")
+}
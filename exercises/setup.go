@@ -0,0 +1,45 @@
+package exercises
+
+import (
+        "errors"
+        "fmt"
+        "gocli-teacher/workspace"
+        "path/filepath"
+)
+
+// setupExercise writes the template of an exercise to main.go in dir, with
+// a go.mod and go.sum that let it build offline, leaving the learner's own
+// work there unless they choose to start over. It returns false if the
+// exercise can't go on.
+func setupExercise(dir, template string) bool {
+        files := workspace.ModuleFiles()
+        files["main.go"] = template
+        choice, err := workspace.Setup(dir, files)
+        if errors.Is(err, workspace.ErrAborted) {
+                fmt.Println("\nExercise aborted. Your files were left as they are.")
+                return false
+        }
+        if err != nil {
+                fmt.Printf("Error creating exercise files: %v\n", err)
+                return false
+        }
+
+        exerciseFile := filepath.Join(dir, "main.go")
+        if choice == workspace.Resumed {
+                fmt.Printf("\nYour program is still at %s\n", exerciseFile)
+                fmt.Println("Carry on editing it to complete the exercise.")
+        } else {
+                fmt.Printf("\nI've created a template file at %s\n", exerciseFile)
+                fmt.Println("Edit this file to complete the exercise.")
+        }
+
+        source, err := workspace.ResolveDependencies(dir)
+        if err != nil {
+                fmt.Printf("\nNote: %s.\n", err)
+                fmt.Println("Programs that import them will need network access the first time they are built.")
+        } else if source == workspace.Vendored {
+                fmt.Println("Its dependencies were copied to the vendor directory, so it builds offline.")
+        }
+        fmt.Printf("Run it from %s with 'go run .'\n", dir)
+        return true
+}
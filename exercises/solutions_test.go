@@ -0,0 +1,90 @@
+package exercises
+
+import (
+	"gocli-teacher/content"
+	"gocli-teacher/grader"
+	"gocli-teacher/registry"
+	"gocli-teacher/utils"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestSolutions builds the reference solution of every built-in exercise in a
+// module of its own and checks that it behaves as the exercise expects and
+// follows its rubric
+func TestSolutions(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds every solution")
+	}
+
+	if err := Register(content.Builtin()); err != nil {
+		t.Fatal(err)
+	}
+	if len(registry.Exercises()) == 0 {
+		t.Fatal("no built-in exercises were registered")
+	}
+
+	mod := toolModule(t)
+	grader.Tool = mod
+	for _, e := range registry.Exercises() {
+		e := e
+		t.Run(e.ID, func(t *testing.T) {
+			t.Parallel()
+			if e.Solution == "" {
+				t.Fatal("exercise has no reference solution")
+			}
+			if len(e.Spec.Cases) == 0 {
+				t.Fatal("exercise has no test cases")
+			}
+			for _, r := range grader.CheckRules(e.Solution, e.Spec.Rubric) {
+				if !r.Passed {
+					t.Errorf("solution does not follow rule %q", r.Rule.Name)
+				}
+			}
+
+			binary, err := grader.Build(t.TempDir(), mod, e.Solution)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, c := range e.Spec.Cases {
+				if r := grader.Run(binary, c); !r.Passed() {
+					t.Errorf("%s: %s\nstdout:\n%s\nstderr:\n%s", c.Name, r.Message, r.Stdout, r.Stderr)
+				}
+			}
+
+			for _, d := range utils.ValidateGoCode("main.go", e.Solution) {
+				if d.Severity == utils.SeverityError {
+					t.Errorf("validator reports an error in the solution: %s", d)
+				}
+			}
+
+			// Grade the solution the way a learner's program is graded
+			dir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(dir, "main.go"), []byte(e.Solution), 0644); err != nil {
+				t.Fatal(err)
+			}
+			report, err := grader.Grade(dir, e.Spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if report.Score() != 100 || !report.Completed() {
+				t.Errorf("solution scores %d%%:\n%s", report.Score(), grader.FormatReport(report))
+			}
+		})
+	}
+}
+
+// toolModule reads the go.mod and go.sum of gocli-teacher
+func toolModule(t *testing.T) grader.Module {
+	t.Helper()
+	var mod grader.Module
+	var err error
+	if mod.GoMod, err = os.ReadFile(filepath.Join("..", "go.mod")); err != nil {
+		t.Fatal(err)
+	}
+	if mod.GoSum, err = os.ReadFile(filepath.Join("..", "go.sum")); err != nil {
+		t.Fatal(err)
+	}
+	return mod
+}
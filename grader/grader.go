@@ -0,0 +1,268 @@
+// Package grader builds Go programs and checks how they behave when run.
+package grader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gocli-teacher/utils"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Module holds the go.mod and go.sum that programs are built with, so they
+// use the same versions of their dependencies as gocli-teacher
+type Module struct {
+	GoMod  []byte
+	GoSum  []byte
+	Vendor string // Directory of vendored dependencies, if they are not in the module cache
+}
+
+// ModuleName is the module path given to the programs being built
+const ModuleName = "exercise"
+
+// Result is the outcome of running a case
+type Result struct {
+	Case     Case
+	Outcome  Outcome
+	Stdout   string
+	Stderr   string
+	ExitCode int
+	Message  string // Why the case failed
+}
+
+// Build writes source as main.go of a new module in dir and compiles it.
+// It returns the path of the binary, or an error holding the compiler output.
+func Build(dir string, mod Module, source string) (string, error) {
+	return build(context.Background(), dir, mod, source)
+}
+
+// build is Build, stopping the compiler when ctx is done
+func build(ctx context.Context, dir string, mod Module, source string) (string, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create build directory: %w", err)
+	}
+
+	files := map[string][]byte{
+		"go.mod":  withModuleName(mod.GoMod, ModuleName),
+		"go.sum":  mod.GoSum,
+		"main.go": []byte(source),
+	}
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), data, 0644); err != nil {
+			return "", fmt.Errorf("failed to write %s: %w", name, err)
+		}
+	}
+
+	// Link the vendor directory rather than copy it, unless links can't be
+	// made here
+	if mod.Vendor != "" {
+		vendor := filepath.Join(dir, "vendor")
+		target, err := filepath.Abs(mod.Vendor)
+		if err != nil || os.Symlink(target, vendor) != nil {
+			if err := utils.CopyDir(mod.Vendor, vendor); err != nil {
+				return "", fmt.Errorf("failed to copy vendor directory: %w", err)
+			}
+		}
+	}
+
+	binary := filepath.Join(dir, ModuleName)
+	cmd := exec.CommandContext(ctx, "go", "build", "-o", binary, ".")
+	cmd.Dir = dir
+	if mod.Vendor != "" {
+		cmd.Env = append(os.Environ(), "GOFLAGS=-mod=vendor")
+	}
+	if out, err := cmd.CombinedOutput(); err != nil {
+		if ctx.Err() != nil {
+			return "", ctx.Err()
+		}
+		if len(out) == 0 {
+			return "", fmt.Errorf("build failed: %w", err)
+		}
+		return "", errors.New(compilerErrors(out))
+	}
+	return binary, nil
+}
+
+// compilerErrors returns the errors in the output of go build without the
+// lines naming the package they are in
+func compilerErrors(out []byte) string {
+	var lines []string
+	for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+		if !strings.HasPrefix(line, "# ") {
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// withModuleName replaces the module path in a go.mod file
+func withModuleName(goMod []byte, name string) []byte {
+	lines := strings.Split(string(goMod), "\n")
+	for i, line := range lines {
+		if strings.HasPrefix(line, "module ") {
+			lines[i] = "module " + name
+			break
+		}
+	}
+	return []byte(strings.Join(lines, "\n"))
+}
+
+// Named returns the module with name as its module path
+func (m Module) Named(name string) Module {
+	m.GoMod = withModuleName(m.GoMod, name)
+	return m
+}
+
+// Requirement is a module required by a go.mod file
+type Requirement struct {
+	Path     string
+	Version  string
+	Indirect bool // Only needed by other requirements
+}
+
+// Requires returns the modules the go.mod requires
+func (m Module) Requires() []Requirement {
+	var requires []Requirement
+	block := false
+	for _, line := range strings.Split(string(m.GoMod), "\n") {
+		line, comment, _ := strings.Cut(line, "//")
+		fields := strings.Fields(line)
+		switch {
+		case len(fields) == 0:
+			continue
+		case block && fields[0] == ")":
+			block = false
+			continue
+		case fields[0] == "require" && len(fields) == 2 && fields[1] == "(":
+			block = true
+			continue
+		case fields[0] == "require":
+			fields = fields[1:]
+		case !block:
+			continue
+		}
+		if len(fields) == 2 {
+			requires = append(requires, Requirement{
+				Path:     fields[0],
+				Version:  fields[1],
+				Indirect: strings.TrimSpace(comment) == "indirect",
+			})
+		}
+	}
+	return requires
+}
+
+// Run runs a program as c describes, within DefaultLimits, and compares
+// what it does with what c expects
+func Run(binary string, c Case) Result {
+	return RunWithin(binary, c, DefaultLimits)
+}
+
+// RunWithin runs a program as c describes within limits. The program runs
+// in a scratch directory of its own with a scrubbed environment, and reads
+// from an empty stdin unless c gives it input.
+func RunWithin(binary string, c Case, limits Limits) Result {
+	return runWithin(context.Background(), binary, c, limits)
+}
+
+// runWithin is RunWithin, stopping the program when ctx is done. The
+// result of a stopped program is not meaningful.
+func runWithin(ctx context.Context, binary string, c Case, limits Limits) Result {
+	if len(c.Script) > 0 {
+		return runScript(ctx, binary, c, limits)
+	}
+	result := Result{Case: c}
+
+	scratch, err := os.MkdirTemp("", "gocli-teacher-run-")
+	if err != nil {
+		result.Message = fmt.Sprintf("failed to create working directory: %s", err)
+		return result
+	}
+	defer os.RemoveAll(scratch)
+
+	ctx, cancel := context.WithTimeout(ctx, limits.Timeout)
+	defer cancel()
+
+	stdout := &cappedBuffer{limit: limits.Output, exceeded: cancel}
+	stderr := &cappedBuffer{limit: limits.Output, exceeded: cancel}
+	cmd := exec.CommandContext(ctx, binary, c.Args...)
+	cmd.Dir = scratch
+	cmd.Env = sandboxEnv(scratch, c.Env)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	if c.Stdin != "" {
+		cmd.Stdin = strings.NewReader(c.Stdin)
+	}
+	// Don't wait for processes the program left behind holding its output
+	cmd.WaitDelay = time.Second
+	isolate(cmd)
+	started, err := limit(cmd, limits)
+	if err != nil {
+		result.Message = err.Error()
+		return result
+	}
+
+	if err := cmd.Start(); err != nil {
+		started()
+		result.Message = fmt.Sprintf("failed to run: %s", err)
+		return result
+	}
+	if err := started(); err != nil {
+		killGroup(cmd)
+		cmd.Wait()
+		result.Message = err.Error()
+		return result
+	}
+	err = cmd.Wait()
+	killGroup(cmd)
+	result.Stdout = stdout.String()
+	result.Stderr = stderr.String()
+
+	var exitErr *exec.ExitError
+	switch {
+	case stdout.over || stderr.over:
+		result.Outcome = TooMuchOutput
+		result.Message = fmt.Sprintf("wrote more than %d KiB of output", limits.Output>>10)
+		return result
+	case ctx.Err() != nil:
+		result.Outcome = TimedOut
+		result.Message = fmt.Sprintf("did not finish within %s", limits.Timeout)
+		return result
+	case errors.As(err, &exitErr):
+		result.ExitCode = exitErr.ExitCode()
+	case err != nil && !errors.Is(err, exec.ErrWaitDelay):
+		result.Message = fmt.Sprintf("failed to run: %s", err)
+		return result
+	}
+
+	if crash := crashMessage(result.ExitCode, result.Stderr, limits); crash != "" {
+		result.Outcome = Crashed
+		result.Message = crash
+		return result
+	}
+	result.check()
+	return result
+}
+
+// check compares the exit code and output of a program that ran to the
+// end with what its case expects
+func (r *Result) check() {
+	if r.ExitCode != r.Case.ExitCode {
+		r.Message = fmt.Sprintf("exit status %d, want %d", r.ExitCode, r.Case.ExitCode)
+	} else if problem := r.Case.Stdout.check(r.Stdout); problem != "" {
+		r.Message = "output " + problem
+	} else if problem := r.Case.Stderr.check(r.Stderr); problem != "" {
+		r.Message = "error output " + problem
+	} else {
+		r.Outcome = Passed
+	}
+}
+
+// Passed reports whether the program did what the case expects
+func (r Result) Passed() bool {
+	return r.Outcome == Passed
+}
@@ -0,0 +1,199 @@
+package grader
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"os/user"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// limitsEnvVar hands the limits to the copy of gocli-teacher that applies
+// them before it runs a program
+const limitsEnvVar = "GOCLI_TEACHER_LIMITS"
+
+// unprivilegedUser runs programs when gocli-teacher runs as root, whom the
+// process limit does not bind
+const unprivilegedUser = "nobody"
+
+// wrapperLimits are what the copy of gocli-teacher applies
+type wrapperLimits struct {
+	Memory    uint64 `json:"memory,omitempty"`    // RLIMIT_DATA
+	Processes uint64 `json:"processes,omitempty"` // RLIMIT_NPROC, counting the user's other tasks
+	UID       int    `json:"uid,omitempty"`       // User to run as, if not the current one
+	GID       int    `json:"gid,omitempty"`
+	Status    int    `json:"status"` // Descriptor a failure is reported on
+}
+
+func init() {
+	if spec, ok := os.LookupEnv(limitsEnvVar); ok {
+		os.Unsetenv(limitsEnvVar)
+		execLimited(spec, os.Args[1:])
+	}
+}
+
+// limit makes cmd start a copy of gocli-teacher that applies the memory and
+// process limits to itself and then executes the program, so the program is
+// bound by them from its first instruction. When gocli-teacher runs as
+// root, the program runs as nobody instead. The returned function is called
+// once cmd has started, or failed to; it waits until the program is
+// executed and reports whether the limits could be applied.
+func limit(cmd *exec.Cmd, limits Limits) (func() error, error) {
+	if limits.Memory == 0 && limits.Processes == 0 {
+		return func() error { return nil }, nil
+	}
+	self, err := os.Executable()
+	if err != nil {
+		return nil, fmt.Errorf("failed to find gocli-teacher to apply limits: %w", err)
+	}
+
+	w := wrapperLimits{Memory: limits.Memory}
+	if limits.Processes > 0 {
+		uid := os.Getuid()
+		if os.Geteuid() == 0 {
+			if w.UID, w.GID, err = lookupUnprivileged(); err != nil {
+				return nil, err
+			}
+			if err := os.Chown(cmd.Dir, w.UID, w.GID); err != nil {
+				return nil, fmt.Errorf("failed to hand the working directory to %s: %w", unprivilegedUser, err)
+			}
+			uid = w.UID
+		}
+		// The limit counts every process and thread of the user, not only
+		// the program's, so it has to start from what is already running
+		w.Processes = userTasks(uid) + limits.Processes
+	}
+
+	status, report, err := os.Pipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply limits: %w", err)
+	}
+	w.Status = 3 + len(cmd.ExtraFiles)
+	cmd.ExtraFiles = append(cmd.ExtraFiles, report)
+	spec, _ := json.Marshal(w)
+	cmd.Env = append(cmd.Env, limitsEnvVar+"="+string(spec))
+	cmd.Args = append([]string{self, cmd.Path}, cmd.Args...)
+	cmd.Path = self
+
+	return func() error {
+		// The copy closes its end when it executes the program
+		report.Close()
+		message, _ := io.ReadAll(status)
+		status.Close()
+		if len(message) > 0 {
+			return errors.New(string(message))
+		}
+		return nil
+	}, nil
+}
+
+// lookupUnprivileged returns the IDs of the user programs run as in place
+// of root
+func lookupUnprivileged() (int, int, error) {
+	u, err := user.Lookup(unprivilegedUser)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to find user %s to run programs as: %w", unprivilegedUser, err)
+	}
+	uid, err := strconv.Atoi(u.Uid)
+	if err != nil {
+		return 0, 0, fmt.Errorf("user %s has no numeric ID", unprivilegedUser)
+	}
+	gid, err := strconv.Atoi(u.Gid)
+	if err != nil {
+		return 0, 0, fmt.Errorf("user %s has no numeric group ID", unprivilegedUser)
+	}
+	return uid, gid, nil
+}
+
+// execLimited applies the limits in spec to this process and executes the
+// program args names with the rest of args as its arguments. It only
+// returns by exiting, after writing why to the status descriptor.
+func execLimited(spec string, args []string) {
+	var w wrapperLimits
+	if err := json.Unmarshal([]byte(spec), &w); err != nil || len(args) < 2 {
+		fmt.Fprintln(os.Stderr, "gocli-teacher: invalid "+limitsEnvVar)
+		os.Exit(127)
+	}
+	unix.CloseOnExec(w.Status)
+	status := os.NewFile(uintptr(w.Status), "status")
+	fail := func(format string, err error) {
+		fmt.Fprintf(status, format, err)
+		os.Exit(127)
+	}
+
+	if w.Memory > 0 {
+		// The Go runtime reserves far more address space than it uses, so
+		// limit the data it actually maps instead
+		if err := unix.Setrlimit(unix.RLIMIT_DATA, &unix.Rlimit{Cur: w.Memory, Max: w.Memory}); err != nil {
+			fail("failed to limit memory: %s", err)
+		}
+	}
+	if w.Processes > 0 {
+		if err := unix.Setrlimit(unix.RLIMIT_NPROC, &unix.Rlimit{Cur: w.Processes, Max: w.Processes}); err != nil {
+			fail("failed to limit processes: %s", err)
+		}
+	}
+
+	path := args[0]
+	if w.UID != 0 {
+		// Open the program while still root, so that the directories on
+		// its path needn't be open to the other user
+		program, err := os.Open(path)
+		if err != nil {
+			fail("failed to open program: %s", err)
+		}
+		path = fmt.Sprintf("/proc/self/fd/%d", program.Fd())
+		if err := syscall.Setgroups(nil); err != nil {
+			fail("failed to drop root: %s", err)
+		}
+		if err := syscall.Setresgid(w.GID, w.GID, w.GID); err != nil {
+			fail("failed to drop root: %s", err)
+		}
+		if err := syscall.Setresuid(w.UID, w.UID, w.UID); err != nil {
+			fail("failed to drop root: %s", err)
+		}
+	}
+	err := syscall.Exec(path, args[1:], os.Environ())
+	fail("failed to run: %s", err)
+}
+
+// userTasks counts the threads of every process the user runs
+func userTasks(uid int) uint64 {
+	statuses, _ := filepath.Glob("/proc/[0-9]*/status")
+
+	var tasks uint64
+	for _, path := range statuses {
+		file, err := os.Open(path)
+		if err != nil {
+			continue
+		}
+
+		owned := false
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			fields := strings.Fields(scanner.Text())
+			if len(fields) < 2 {
+				continue
+			}
+			switch fields[0] {
+			case "Uid:":
+				owned = fields[1] == strconv.Itoa(uid)
+			case "Threads:":
+				if n, err := strconv.ParseUint(fields[1], 10, 64); err == nil && owned {
+					tasks += n
+				}
+			}
+		}
+		file.Close()
+	}
+	return tasks
+}
@@ -0,0 +1,25 @@
+//go:build unix
+
+package grader
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// isolate starts the program in a process group of its own, so that
+// everything it starts can be killed with it
+func isolate(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Cancel = func() error {
+		return killGroup(cmd)
+	}
+}
+
+// killGroup kills the program and every process it started
+func killGroup(cmd *exec.Cmd) error {
+	if cmd.Process == nil {
+		return nil
+	}
+	return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+}
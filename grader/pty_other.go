@@ -0,0 +1,14 @@
+//go:build !unix
+
+package grader
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+)
+
+// startTerminal fails: programs can only be run in a terminal on Unix
+func startTerminal(cmd *exec.Cmd, rows, cols int) (*os.File, error) {
+	return nil, errors.New("interactive cases need a pseudo-terminal, which this system does not provide")
+}
@@ -0,0 +1,19 @@
+//go:build unix
+
+package grader
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+
+	"github.com/creack/pty"
+)
+
+// startTerminal starts the program in a new session with a pseudo-terminal
+// of the given size as its controlling terminal, and returns the terminal's
+// other end. The session is also the program's process group.
+func startTerminal(cmd *exec.Cmd, rows, cols int) (*os.File, error) {
+	size := &pty.Winsize{Rows: uint16(rows), Cols: uint16(cols)}
+	return pty.StartWithAttrs(cmd, size, &syscall.SysProcAttr{Setsid: true, Setctty: true})
+}
@@ -0,0 +1,252 @@
+package grader
+
+import (
+	"context"
+	"fmt"
+	"gocli-teacher/utils"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Tool is the module of gocli-teacher itself. Learner programs are built
+// with its go.mod and go.sum, which main embeds and sets here.
+var Tool Module
+
+// Report is the outcome of grading a program against its test cases
+type Report struct {
+	BuildError string // Compiler output if the program did not build
+	Results    []Result
+	Rubric     []RuleResult
+}
+
+// Built reports whether the program compiled
+func (r Report) Built() bool {
+	return r.BuildError == ""
+}
+
+// Passed returns the number of test cases that passed
+func (r Report) Passed() int {
+	passed := 0
+	for _, result := range r.Results {
+		if result.Passed() {
+			passed++
+		}
+	}
+	return passed
+}
+
+// RulesMet returns the number of rubric rules the code follows
+func (r Report) RulesMet() int {
+	met := 0
+	for _, result := range r.Rubric {
+		if result.Passed {
+			met++
+		}
+	}
+	return met
+}
+
+// Hints returns the number of hints the report shows: those of the test
+// cases that failed after the program built and of the rules not met
+func (r Report) Hints() int {
+	hints := 0
+	for _, result := range r.Results {
+		if !result.Passed() && r.Built() && result.Case.Hint != "" {
+			hints++
+		}
+	}
+	for _, result := range r.Rubric {
+		if !result.Passed && result.Rule.Hint != "" {
+			hints++
+		}
+	}
+	return hints
+}
+
+// runProblems lists how many cases timed out, crashed or wrote too much
+// output, which are not counted as wrong answers
+func (r Report) runProblems() string {
+	counts := make(map[Outcome]int)
+	for _, result := range r.Results {
+		counts[result.Outcome]++
+	}
+
+	var problems []string
+	for _, outcome := range []Outcome{TimedOut, Crashed, TooMuchOutput} {
+		if counts[outcome] > 0 {
+			problems = append(problems, fmt.Sprintf("%d %s", counts[outcome], strings.ToLower(outcome.String())))
+		}
+	}
+	return strings.Join(problems, ", ")
+}
+
+// total returns the number of test cases and rules
+func (r Report) total() int {
+	return len(r.Results) + len(r.Rubric)
+}
+
+// Completed reports whether the program built, passed every test case and
+// followed every rule
+func (r Report) Completed() bool {
+	return r.Built() && r.total() > 0 && r.Passed()+r.RulesMet() == r.total()
+}
+
+// Score returns the percentage of test cases and rules that passed, each
+// counting the same
+func (r Report) Score() int {
+	if r.total() == 0 {
+		return 0
+	}
+	return (r.Passed() + r.RulesMet()) * 100 / r.total()
+}
+
+// Grade builds main.go from dir with the Tool module, runs it against the
+// cases of spec and checks its code against the rubric. Build failures are
+// part of the report; the error is only set when grading could not happen
+// at all.
+func Grade(dir string, spec Spec) (Report, error) {
+	return GradeContext(context.Background(), dir, spec)
+}
+
+// GradeContext is Grade, stopping the build or the case being run as soon
+// as ctx is done and returning its error
+func GradeContext(ctx context.Context, dir string, spec Spec) (Report, error) {
+	source, err := os.ReadFile(filepath.Join(dir, "main.go"))
+	if err != nil {
+		return Report{}, fmt.Errorf("failed to read your program: %w", err)
+	}
+	if len(Tool.GoMod) == 0 {
+		return Report{}, fmt.Errorf("gocli-teacher was built without its go.mod")
+	}
+
+	var report Report
+	report.Rubric = CheckRules(string(source), spec.Rubric)
+
+	buildDir, err := os.MkdirTemp("", "gocli-teacher-grade-")
+	if err != nil {
+		return Report{}, fmt.Errorf("failed to create build directory: %w", err)
+	}
+	defer os.RemoveAll(buildDir)
+
+	// Build offline from the learner's vendor directory when they have one
+	mod := Tool
+	if _, err := os.Stat(filepath.Join(dir, "vendor", "modules.txt")); err == nil {
+		mod.Vendor = filepath.Join(dir, "vendor")
+	}
+	binary, err := build(ctx, buildDir, mod, string(source))
+	if ctx.Err() != nil {
+		return Report{}, ctx.Err()
+	}
+	if err != nil {
+		report.BuildError = err.Error()
+		report.failAll(spec.Cases, "program did not build")
+		return report, nil
+	}
+
+	for _, c := range spec.Cases {
+		result := runWithin(ctx, binary, c, DefaultLimits)
+		if ctx.Err() != nil {
+			return Report{}, ctx.Err()
+		}
+		report.Results = append(report.Results, result)
+	}
+	return report, nil
+}
+
+// Diagnose type-checks main.go in dir and returns the problems it finds
+// with their line and column. It takes far less time than building, so the
+// learner sees them before the compiler runs. The validator does not load
+// third-party packages, so only the compiler decides whether the program
+// builds.
+func Diagnose(dir string) ([]utils.Diagnostic, error) {
+	source, err := os.ReadFile(filepath.Join(dir, "main.go"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read your program: %w", err)
+	}
+	return utils.ValidateGoCode("main.go", string(source)), nil
+}
+
+// FormatDiagnostics formats the problems Diagnose found, or returns "" if
+// there are none
+func FormatDiagnostics(diagnostics []utils.Diagnostic) string {
+	if len(diagnostics) == 0 {
+		return ""
+	}
+	var sb strings.Builder
+	if utils.HasErrors(diagnostics) {
+		sb.WriteString("Your program has errors:\n\n")
+	} else {
+		sb.WriteString("Things to look at in your program:\n\n")
+	}
+	for _, d := range diagnostics {
+		sb.WriteString(d.String() + "\n")
+	}
+	sb.WriteString("\n")
+	return sb.String()
+}
+
+// failAll records every case as failed for the same reason
+func (r *Report) failAll(cases []Case, message string) {
+	for _, c := range cases {
+		r.Results = append(r.Results, Result{Case: c, Outcome: NotRun, Message: message})
+	}
+}
+
+// FormatReport formats the result of every test case and the score
+func FormatReport(r Report) string {
+	var sb strings.Builder
+	if r.BuildError != "" {
+		sb.WriteString("Your program did not build:\n\n")
+		sb.WriteString(r.BuildError)
+		sb.WriteString("\n\n")
+	}
+
+	for _, result := range r.Results {
+		status := "[✓]"
+		if !result.Passed() {
+			status = "[✗]"
+		}
+		sb.WriteString(fmt.Sprintf("%s %s", status, result.Case.Name))
+		if len(result.Case.Args) > 0 {
+			sb.WriteString(fmt.Sprintf(" (%s)", strings.Join(result.Case.Args, " ")))
+		}
+		sb.WriteString("\n")
+		if !result.Passed() && r.Built() {
+			switch result.Outcome {
+			case TimedOut, Crashed, TooMuchOutput:
+				// Not a wrong answer: the program never got to give one
+				sb.WriteString(fmt.Sprintf("    %s: %s\n", result.Outcome, result.Message))
+			default:
+				sb.WriteString(fmt.Sprintf("    %s\n", result.Message))
+			}
+			if result.Case.Hint != "" {
+				sb.WriteString(fmt.Sprintf("    Hint: %s\n", result.Case.Hint))
+			}
+		}
+	}
+
+	if len(r.Rubric) > 0 {
+		sb.WriteString("\nCode review:\n")
+		for _, result := range r.Rubric {
+			if result.Passed {
+				sb.WriteString(fmt.Sprintf("[✓] %s\n", result.Rule.Name))
+				continue
+			}
+			sb.WriteString(fmt.Sprintf("[✗] %s\n", result.Rule.Name))
+			if result.Rule.Hint != "" {
+				sb.WriteString(fmt.Sprintf("    Hint: %s\n", result.Rule.Hint))
+			}
+		}
+	}
+
+	sb.WriteString(fmt.Sprintf("\nPassed %d/%d test cases", r.Passed(), len(r.Results)))
+	if problems := r.runProblems(); problems != "" {
+		sb.WriteString(fmt.Sprintf(" (%s)", problems))
+	}
+	if len(r.Rubric) > 0 {
+		sb.WriteString(fmt.Sprintf(" and %d/%d code checks", r.RulesMet(), len(r.Rubric)))
+	}
+	sb.WriteString(fmt.Sprintf(" (%d%%)\n", r.Score()))
+	return sb.String()
+}
@@ -0,0 +1,280 @@
+package grader
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"gocli-teacher/utils"
+	"strconv"
+	"strings"
+)
+
+// Rule is a requirement on the structure of the learner's code, for what
+// cannot be seen in the program's output. Exactly one of Uses, Calls and
+// CommandDepth is set.
+type Rule struct {
+	Name         string   `yaml:"name"`
+	Uses         string   `yaml:"uses"`          // Package member that must be used, like cobra.Command
+	Calls        string   `yaml:"calls"`         // Function that must be called, like survey.Ask, or method, like MarkFlagRequired
+	Args         []string `yaml:"args"`          // String arguments one of the calls must have
+	CommandDepth int      `yaml:"command_depth"` // Levels of commands added with AddCommand below the root
+	Hint         string   `yaml:"hint"`          // Shown when the rule is not met
+}
+
+// RuleResult is the outcome of checking a rule
+type RuleResult struct {
+	Rule   Rule
+	Passed bool
+}
+
+// validate checks that a rule says what to look for
+func (r Rule) validate() error {
+	if r.Name == "" {
+		return fmt.Errorf("rule is missing a name")
+	}
+
+	kinds := 0
+	for _, set := range []bool{r.Uses != "", r.Calls != "", r.CommandDepth > 0} {
+		if set {
+			kinds++
+		}
+	}
+	if kinds != 1 {
+		return fmt.Errorf("rule %q needs exactly one of uses, calls and command_depth", r.Name)
+	}
+	if r.Uses != "" && !strings.Contains(r.Uses, ".") {
+		return fmt.Errorf("rule %q: uses needs a package and a name, like cobra.Command", r.Name)
+	}
+	if len(r.Args) > 0 && r.Calls == "" {
+		return fmt.Errorf("rule %q: args can only be given with calls", r.Name)
+	}
+	return nil
+}
+
+// CheckRules checks the rules against the source of a Go file. If the
+// source cannot be parsed, no rule is met.
+func CheckRules(source string, rules []Rule) []RuleResult {
+	results := make([]RuleResult, len(rules))
+	for i, rule := range rules {
+		results[i].Rule = rule
+	}
+
+	file, err := parser.ParseFile(token.NewFileSet(), "main.go", source, 0)
+	if err != nil {
+		return results
+	}
+
+	code := newCodeIndex(file)
+	for i, rule := range rules {
+		switch {
+		case rule.Uses != "":
+			results[i].Passed = code.uses[rule.Uses]
+		case rule.Calls != "":
+			results[i].Passed = code.calls(rule.Calls, rule.Args)
+		case rule.CommandDepth > 0:
+			results[i].Passed = code.commandDepth() >= rule.CommandDepth
+		}
+	}
+	return results
+}
+
+// codeIndex records what a file uses and calls
+type codeIndex struct {
+	uses        map[string]bool       // Package members used, by package name and member
+	callArgs    map[string][][]string // String arguments of every call, by function or method name
+	subcommands map[any][]any         // Commands added to each command with AddCommand, by commandKey
+}
+
+// newCodeIndex walks a file and indexes what it uses and calls
+func newCodeIndex(file *ast.File) *codeIndex {
+	code := &codeIndex{
+		uses:        make(map[string]bool),
+		callArgs:    make(map[string][][]string),
+		subcommands: make(map[any][]any),
+	}
+
+	// Package names by the name they are imported as
+	packages := make(map[string]string)
+	for _, spec := range file.Imports {
+		importPath, _ := strconv.Unquote(spec.Path.Value)
+		name := utils.PackageName(importPath)
+		local := name
+		if spec.Name != nil {
+			local = spec.Name.Name
+		}
+		packages[local] = name
+	}
+
+	// qualified returns pkg.Name for a selector on an imported package
+	qualified := func(sel *ast.SelectorExpr) (string, bool) {
+		x, ok := sel.X.(*ast.Ident)
+		if !ok || x.Obj != nil {
+			return "", false
+		}
+		pkg, ok := packages[x.Name]
+		if !ok {
+			return "", false
+		}
+		return pkg + "." + sel.Sel.Name, true
+	}
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.SelectorExpr:
+			if name, ok := qualified(n); ok {
+				code.uses[name] = true
+			}
+		case *ast.CallExpr:
+			var names []string
+			switch fun := n.Fun.(type) {
+			case *ast.Ident:
+				names = append(names, fun.Name)
+			case *ast.SelectorExpr:
+				if name, ok := qualified(fun); ok {
+					names = append(names, name)
+				} else {
+					names = append(names, fun.Sel.Name)
+					if fun.Sel.Name == "AddCommand" {
+						parent := commandKey(fun.X, nil)
+						for _, arg := range n.Args {
+							code.subcommands[parent] = append(code.subcommands[parent], commandKey(arg, nil))
+						}
+					}
+				}
+			}
+
+			var args []string
+			for _, arg := range n.Args {
+				if lit, ok := arg.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+					if s, err := strconv.Unquote(lit.Value); err == nil {
+						args = append(args, s)
+					}
+				}
+			}
+			for _, name := range names {
+				code.callArgs[name] = append(code.callArgs[name], args)
+			}
+		}
+		return true
+	})
+	return code
+}
+
+// commandKey identifies the command an expression refers to. Variables are
+// told apart by their declaration, so locals of the same name in different
+// functions are different commands, and a call to a function declared in
+// the file refers to the command the function returns. Other expressions,
+// such as struct fields, are identified by their text. calling holds the
+// functions being followed, so that recursion ends.
+func commandKey(expr ast.Expr, calling map[*ast.Object]bool) any {
+	switch e := expr.(type) {
+	case *ast.ParenExpr:
+		return commandKey(e.X, calling)
+	case *ast.UnaryExpr:
+		if e.Op == token.AND {
+			return commandKey(e.X, calling)
+		}
+	case *ast.CompositeLit:
+		return e
+	case *ast.Ident:
+		if e.Obj != nil && e.Obj.Kind == ast.Var {
+			return e.Obj
+		}
+	case *ast.CallExpr:
+		fun, ok := e.Fun.(*ast.Ident)
+		if !ok || fun.Obj == nil || fun.Obj.Kind != ast.Fun || calling[fun.Obj] {
+			break
+		}
+		decl, ok := fun.Obj.Decl.(*ast.FuncDecl)
+		if !ok || decl.Body == nil {
+			break
+		}
+		if calling == nil {
+			calling = make(map[*ast.Object]bool)
+		}
+		calling[fun.Obj] = true
+		defer delete(calling, fun.Obj)
+		if returned := returnedCommand(decl.Body); returned != nil {
+			return commandKey(returned, calling)
+		}
+	}
+	return types.ExprString(expr)
+}
+
+// returnedCommand returns the first result of the first return statement
+// of a function body, outside function literals, or nil if there is none
+func returnedCommand(body *ast.BlockStmt) ast.Expr {
+	var returned ast.Expr
+	ast.Inspect(body, func(n ast.Node) bool {
+		if returned != nil {
+			return false
+		}
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.ReturnStmt:
+			if len(n.Results) > 0 {
+				returned = n.Results[0]
+			}
+		}
+		return true
+	})
+	return returned
+}
+
+// calls reports whether a function or method is called with all of args
+func (c *codeIndex) calls(name string, args []string) bool {
+	for _, callArgs := range c.callArgs[name] {
+		if containsAll(callArgs, args) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsAll reports whether have holds every string in want
+func containsAll(have, want []string) bool {
+	for _, w := range want {
+		found := false
+		for _, h := range have {
+			if h == w {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// commandDepth returns how many levels of commands are added below the
+// root command, which is never added to another one
+func (c *codeIndex) commandDepth() int {
+	var depth func(cmd any, seen map[any]bool) int
+	depth = func(cmd any, seen map[any]bool) int {
+		if seen[cmd] {
+			return 0
+		}
+		seen[cmd] = true
+		deepest := 0
+		for _, sub := range c.subcommands[cmd] {
+			if d := 1 + depth(sub, seen); d > deepest {
+				deepest = d
+			}
+		}
+		delete(seen, cmd)
+		return deepest
+	}
+
+	deepest := 0
+	for cmd := range c.subcommands {
+		if d := depth(cmd, make(map[any]bool)); d > deepest {
+			deepest = d
+		}
+	}
+	return deepest
+}
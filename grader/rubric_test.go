@@ -0,0 +1,164 @@
+package grader
+
+import "testing"
+
+// program is a cobra program with a greet command and its flags
+const program = `package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var rootCmd = &cobra.Command{Use: "app"}
+
+var greetCmd = &cobra.Command{
+	Use: "greet",
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("Hello")
+	},
+}
+
+func main() {
+	greetCmd.Flags().String("name", "", "who to greet")
+	greetCmd.MarkFlagRequired("name")
+	rootCmd.AddCommand(greetCmd)
+	if err := rootCmd.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
+`
+
+// constructors builds its commands in functions, as cobra generates them
+const constructors = `package main
+
+import "github.com/spf13/cobra"
+
+func newRootCmd() *cobra.Command {
+	cmd := &cobra.Command{Use: "app"}
+	cmd.AddCommand(newConfigCmd())
+	return cmd
+}
+
+func newConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{Use: "config"}
+	cmd.AddCommand(newSetCmd(), &cobra.Command{Use: "get"})
+	return cmd
+}
+
+func newSetCmd() *cobra.Command {
+	return &cobra.Command{Use: "set"}
+}
+
+func main() {
+	newRootCmd().Execute()
+}
+`
+
+// sameNames adds a command to a local named like the one main adds, which
+// is a different command
+const sameNames = `package main
+
+import "github.com/spf13/cobra"
+
+func newVersionCmd() *cobra.Command {
+	cmd := &cobra.Command{Use: "version"}
+	cmd.AddCommand(&cobra.Command{Use: "short"})
+	return cmd
+}
+
+func main() {
+	root := &cobra.Command{Use: "app"}
+	cmd := &cobra.Command{Use: "greet"}
+	root.AddCommand(cmd)
+	newVersionCmd()
+	root.Execute()
+}
+`
+
+func TestCheckRules(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		rule   Rule
+		want   bool
+	}{
+		{"uses", program, Rule{Uses: "cobra.Command"}, true},
+		{"uses missing", program, Rule{Uses: "survey.Ask"}, false},
+		{"uses through an alias", `package main
+
+import cli "github.com/spf13/cobra"
+
+func main() { (&cli.Command{}).Execute() }
+`, Rule{Uses: "cobra.Command"}, true},
+		{"local named like a package", `package main
+
+func main() {
+	cobra := struct{ Command int }{}
+	_ = cobra.Command
+}
+`, Rule{Uses: "cobra.Command"}, false},
+		{"calls method", program, Rule{Calls: "MarkFlagRequired"}, true},
+		{"calls missing", program, Rule{Calls: "MarkPersistentFlagRequired"}, false},
+		{"calls with args", program, Rule{Calls: "MarkFlagRequired", Args: []string{"name"}}, true},
+		{"calls without args", program, Rule{Calls: "MarkFlagRequired", Args: []string{"greeting"}}, false},
+		{"calls package function through an alias", `package main
+
+import f "fmt"
+
+func main() { f.Println("hi") }
+`, Rule{Calls: "fmt.Println", Args: []string{"hi"}}, true},
+		{"depth", program, Rule{CommandDepth: 1}, true},
+		{"shallower depth", program, Rule{CommandDepth: 2}, false},
+		{"depth through constructors", constructors, Rule{CommandDepth: 2}, true},
+		{"deeper than constructors", constructors, Rule{CommandDepth: 3}, false},
+		{"locals with the same name", sameNames, Rule{CommandDepth: 1}, true},
+		{"locals with the same name are not merged", sameNames, Rule{CommandDepth: 2}, false},
+		{"recursive constructor", `package main
+
+import "github.com/spf13/cobra"
+
+func newCmd() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.AddCommand(newCmd())
+	return cmd
+}
+
+func main() { newCmd() }
+`, Rule{CommandDepth: 5}, false},
+		{"syntax error", "package main\n\nfunc main() {\n", Rule{Uses: "fmt.Println"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.rule.Name = tt.name
+			results := CheckRules(tt.source, []Rule{tt.rule})
+			if got := results[0].Passed; got != tt.want {
+				t.Errorf("rule met = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRuleValidate(t *testing.T) {
+	tests := []struct {
+		rule  Rule
+		valid bool
+	}{
+		{Rule{Name: "r", Uses: "cobra.Command"}, true},
+		{Rule{Name: "r", Calls: "MarkFlagRequired", Args: []string{"name"}}, true},
+		{Rule{Name: "r", CommandDepth: 2}, true},
+		{Rule{Uses: "cobra.Command"}, false},
+		{Rule{Name: "r"}, false},
+		{Rule{Name: "r", Uses: "cobra.Command", CommandDepth: 1}, false},
+		{Rule{Name: "r", Uses: "Command"}, false},
+		{Rule{Name: "r", Uses: "cobra.Command", Args: []string{"x"}}, false},
+	}
+	for _, tt := range tests {
+		if err := tt.rule.validate(); (err == nil) != tt.valid {
+			t.Errorf("%+v: got error %v, want valid %v", tt.rule, err, tt.valid)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package grader
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Limits bound what a program may do while it runs for a case
+type Limits struct {
+	Timeout   time.Duration // Wall-clock time for the whole run
+	Memory    uint64        // Bytes of memory
+	Processes uint64        // Processes and threads on top of the user's own
+	Output    int           // Bytes kept of stdout and of stderr
+}
+
+// DefaultLimits are the limits programs are run with. The memory and
+// process limits only apply on Linux, where they are set before the program
+// starts. Root is not bound by the process limit, so when gocli-teacher
+// runs as root the program runs as nobody.
+var DefaultLimits = Limits{
+	Timeout:   10 * time.Second,
+	Memory:    512 << 20,
+	Processes: 64,
+	Output:    1 << 20,
+}
+
+// Outcome says how a case ended
+type Outcome int
+
+const (
+	Failed        Outcome = iota // The program ran but did not do what the case expects
+	Passed                       // The program did what the case expects
+	TimedOut                     // The program did not finish in time
+	Crashed                      // The program panicked, ran out of memory or was killed
+	TooMuchOutput                // The program wrote more than the output limit
+	NotRun                       // The program did not build, so it was not run
+)
+
+// String describes the outcome for a report
+func (o Outcome) String() string {
+	switch o {
+	case Passed:
+		return "Passed"
+	case TimedOut:
+		return "Timed out"
+	case Crashed:
+		return "Crashed"
+	case TooMuchOutput:
+		return "Too much output"
+	case NotRun:
+		return "Not run"
+	default:
+		return "Wrong answer"
+	}
+}
+
+// cappedBuffer keeps the first limit bytes written to it and calls
+// exceeded once when more are written
+type cappedBuffer struct {
+	buf      bytes.Buffer
+	limit    int
+	over     bool
+	exceeded func()
+	once     sync.Once
+}
+
+func (b *cappedBuffer) Write(p []byte) (int, error) {
+	if room := b.limit - b.buf.Len(); len(p) > room {
+		b.buf.Write(p[:max(room, 0)])
+		b.over = true
+		b.once.Do(b.exceeded)
+		return len(p), nil
+	}
+	return b.buf.Write(p)
+}
+
+func (b *cappedBuffer) String() string {
+	return b.buf.String()
+}
+
+// sandboxEnv returns the environment programs run with: the PATH, a home
+// and temporary directory in the scratch directory, and the case's own
+// variables. Nothing else of the user's environment is passed on.
+func sandboxEnv(scratch string, env map[string]string) []string {
+	vars := []string{
+		"PATH=" + os.Getenv("PATH"),
+		"HOME=" + scratch,
+		"TMPDIR=" + scratch,
+	}
+	for name, value := range env {
+		vars = append(vars, name+"="+value)
+	}
+	return vars
+}
+
+// goCrash matches the message a Go program prints when it panics or the
+// runtime gives up, including on a signal in the runtime's own code. It
+// starts a line, or in a terminal can follow a prompt on the same line.
+var goCrash = regexp.MustCompile(`(?m)(?:^|\s)(panic|fatal error|SIG[A-Z]+): (.*)$`)
+
+// goTrace matches the first goroutine of the stack trace the runtime prints
+// after the message, which a program printing such text itself lacks
+var goTrace = regexp.MustCompile(`(?m)^goroutine \d+ .*\[.*\]:$`)
+
+func crashMessage(code int, stderr string, limits Limits) string {
+	if code == -1 {
+		return "killed by a signal"
+	}
+	// The runtime exits with status 2 after a panic or fatal error
+	if code != 2 {
+		return ""
+	}
+	trace := goTrace.FindStringIndex(stderr)
+	if trace == nil {
+		return ""
+	}
+	match := goCrash.FindStringSubmatch(stderr[:trace[0]])
+	if match == nil {
+		return ""
+	}
+	// A fault in the learner's code is reported as a panic, so one in the
+	// runtime itself comes from the allocator failing at the memory limit
+	outOfMemory := match[1] == "fatal error" && strings.Contains(match[2], "out of memory")
+	if outOfMemory || strings.HasPrefix(match[1], "SIG") && limits.Memory > 0 {
+		return fmt.Sprintf("ran out of memory (limit %d MiB)", limits.Memory>>20)
+	}
+	return match[1] + ": " + match[2]
+}
@@ -0,0 +1,355 @@
+package grader
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+// buildFixture builds a program that only uses the standard library
+func buildFixture(t *testing.T, source string) string {
+	t.Helper()
+	mod := Module{GoMod: []byte("module fixture\n\ngo 1.22\n")}
+	binary, err := Build(t.TempDir(), mod, source)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return binary
+}
+
+// behaviors is a program that does what its first argument says
+const behaviors = `package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+func main() {
+	switch os.Args[1] {
+	case "echo":
+		fmt.Println(strings.Join(os.Args[2:], " "))
+	case "exit":
+		code, _ := strconv.Atoi(os.Args[2])
+		fmt.Fprintln(os.Stderr, "exiting")
+		os.Exit(code)
+	case "sleep":
+		time.Sleep(time.Minute)
+	case "flood":
+		for {
+			fmt.Println("spam spam spam spam spam spam spam")
+		}
+	case "panic":
+		panic("boom")
+	case "kill":
+		syscall.Kill(os.Getpid(), syscall.SIGKILL)
+		time.Sleep(time.Second)
+	case "memory":
+		var chunks [][]byte
+		for i := 0; i < 64; i++ {
+			chunk := make([]byte, 64<<20)
+			for j := range chunk {
+				chunk[j] = 1
+			}
+			chunks = append(chunks, chunk)
+		}
+		fmt.Println(len(chunks))
+	case "env":
+		for _, v := range os.Environ() {
+			fmt.Println(v)
+		}
+	case "pwd":
+		dir, _ := os.Getwd()
+		fmt.Println(dir)
+	case "stdin":
+		data, _ := io.ReadAll(os.Stdin)
+		fmt.Printf("read %d: %s\n", len(data), data)
+	case "background":
+		cmd := exec.Command("sleep", "30")
+		cmd.Stdout = os.Stdout
+		cmd.Start()
+		fmt.Println("left sleep running")
+	case "spawn":
+		for i := 0; i < 1000; i++ {
+			if err := exec.Command("sleep", "30").Start(); err != nil {
+				fmt.Printf("started %d: %s\n", i, err)
+				os.Exit(3)
+			}
+		}
+		fmt.Println("started all")
+	}
+}
+`
+
+// quick are limits that let the tests finish fast
+var quick = Limits{Timeout: 2 * time.Second, Memory: 512 << 20, Processes: 64, Output: 64 << 10}
+
+func TestRunWithin(t *testing.T) {
+	binary := buildFixture(t, behaviors)
+	t.Setenv("GOCLI_TEACHER_SECRET", "hunter2")
+
+	tests := []struct {
+		name     string
+		c        Case
+		outcome  Outcome
+		message  string // Text the message has to contain
+		linuxSet bool   // Only on Linux, where memory is limited
+	}{
+		{
+			name:    "passes",
+			c:       Case{Args: []string{"echo", "hi", "there"}, Stdout: Match{Equals: ptr("hi there\n")}},
+			outcome: Passed,
+		},
+		{
+			name:    "wrong output",
+			c:       Case{Args: []string{"echo", "hi"}, Stdout: Match{Contains: "bye"}},
+			outcome: Failed,
+			message: `output does not contain "bye"`,
+		},
+		{
+			name:    "wrong exit code",
+			c:       Case{Args: []string{"exit", "4"}},
+			outcome: Failed,
+			message: "exit status 4, want 0",
+		},
+		{
+			name:    "expected exit code and stderr",
+			c:       Case{Args: []string{"exit", "1"}, ExitCode: 1, Stderr: Match{Contains: "exiting"}},
+			outcome: Passed,
+		},
+		{
+			name:    "timeout",
+			c:       Case{Args: []string{"sleep"}},
+			outcome: TimedOut,
+			message: "did not finish within 2s",
+		},
+		{
+			name:    "output cap",
+			c:       Case{Args: []string{"flood"}},
+			outcome: TooMuchOutput,
+			message: "wrote more than 64 KiB",
+		},
+		{
+			name:    "panic",
+			c:       Case{Args: []string{"panic"}},
+			outcome: Crashed,
+			message: "panic: boom",
+		},
+		{
+			name:    "killed",
+			c:       Case{Args: []string{"kill"}},
+			outcome: Crashed,
+			message: "killed by a signal",
+		},
+		{
+			name:     "out of memory",
+			c:        Case{Args: []string{"memory"}},
+			outcome:  Crashed,
+			message:  "ran out of memory (limit 512 MiB)",
+			linuxSet: true,
+		},
+		{
+			name:    "closed stdin",
+			c:       Case{Args: []string{"stdin"}, Stdout: Match{Equals: ptr("read 0: \n")}},
+			outcome: Passed,
+		},
+		{
+			name:    "given stdin",
+			c:       Case{Args: []string{"stdin"}, Stdin: "hello", Stdout: Match{Equals: ptr("read 5: hello\n")}},
+			outcome: Passed,
+		},
+		{
+			name:    "processes left behind",
+			c:       Case{Args: []string{"background"}, Stdout: Match{Contains: "left sleep running"}},
+			outcome: Passed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.linuxSet && runtime.GOOS != "linux" {
+				t.Skip("memory is only limited on Linux")
+			}
+			start := time.Now()
+			r := RunWithin(binary, tt.c, quick)
+			if r.Outcome != tt.outcome || !strings.Contains(r.Message, tt.message) {
+				t.Errorf("got %s (%s), want %s (%s)\nstdout: %.200q\nstderr: %.200q",
+					r.Outcome, r.Message, tt.outcome, tt.message, r.Stdout, r.Stderr)
+			}
+			if elapsed := time.Since(start); elapsed > quick.Timeout+2*time.Second {
+				t.Errorf("took %s", elapsed)
+			}
+		})
+	}
+}
+
+func TestCrashMessage(t *testing.T) {
+	limits := Limits{Memory: 256 << 20}
+	tests := []struct {
+		name   string
+		code   int
+		stderr string
+		want   string
+	}{
+		{"normal exit", 0, "", ""},
+		{"exit 2 without a crash", 2, "usage: greet <name>\n", ""},
+		{"usage error naming a panic", 2, "panic: no such mode\nusage: greet <name>\n", ""},
+		{"signal", -1, "", "killed by a signal"},
+		{"panic", 2, "panic: boom\n\ngoroutine 1 [running]:\nmain.main()\n", "panic: boom"},
+		{"nil pointer", 2, "panic: runtime error: invalid memory address or nil pointer dereference\n[signal SIGSEGV: segmentation violation]\n\ngoroutine 1 [running]:\n", "panic: runtime error: invalid memory address or nil pointer dereference"},
+		{"runtime out of memory", 2, "fatal error: runtime: out of memory\n\nruntime stack:\nruntime.throw()\n\ngoroutine 1 [running]:\n", "ran out of memory (limit 256 MiB)"},
+		{"arena out of memory", 2, "fatal error: out of memory allocating heap arena metadata\n\nruntime stack:\n\ngoroutine 1 gp=0x21e7525aa1e0 m=0 mp=0x572240 [running]:\n", "ran out of memory (limit 256 MiB)"},
+		{"fault in the runtime", 2, "SIGSEGV: segmentation violation\nPC=0x430cdc m=0 sigcode=1\n\ngoroutine 0 gp=0x5d07a0 m=0 mp=0x5d1560 [idle]:\n", "ran out of memory (limit 256 MiB)"},
+		{"after a prompt", 2, "Name? panic: boom\n\ngoroutine 1 [running]:\n", "panic: boom"},
+		{"word ending in panic", 2, "nopanic: boom\n\ngoroutine 1 [running]:\n", ""},
+		{"message after the trace", 2, "goroutine 1 [running]:\npanic: boom\n", ""},
+		{"deadlock", 2, "fatal error: all goroutines are asleep - deadlock!\n\ngoroutine 1 [chan receive]:\n", "fatal error: all goroutines are asleep - deadlock!"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := crashMessage(tt.code, tt.stderr, limits); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunWithinEnvironment(t *testing.T) {
+	binary := buildFixture(t, behaviors)
+	t.Setenv("GOCLI_TEACHER_SECRET", "hunter2")
+
+	r := RunWithin(binary, Case{Args: []string{"env"}, Env: map[string]string{"GREETING": "hi"}}, quick)
+	if !r.Passed() {
+		t.Fatalf("%s: %s", r.Outcome, r.Message)
+	}
+	vars := make(map[string]string)
+	for _, line := range strings.Split(strings.TrimSpace(r.Stdout), "\n") {
+		name, value, _ := strings.Cut(line, "=")
+		vars[name] = value
+	}
+	if _, ok := vars["GOCLI_TEACHER_SECRET"]; ok {
+		t.Error("the user's environment leaked into the program's")
+	}
+	if _, ok := vars[limitsEnvVar]; ok && runtime.GOOS == "linux" {
+		t.Error("the limits passed to the wrapper leaked into the program's environment")
+	}
+	if vars["GREETING"] != "hi" {
+		t.Errorf("GREETING = %q, want the case's hi", vars["GREETING"])
+	}
+	if vars["HOME"] == "" || vars["HOME"] != vars["TMPDIR"] {
+		t.Errorf("HOME = %q and TMPDIR = %q, want both in the scratch directory", vars["HOME"], vars["TMPDIR"])
+	}
+
+	pwd := RunWithin(binary, Case{Args: []string{"pwd"}}, quick)
+	if dir := strings.TrimSpace(pwd.Stdout); dir == "" || !strings.Contains(dir, "gocli-teacher-run-") {
+		t.Errorf("program ran in %q, want a scratch directory", dir)
+	}
+}
+
+func TestGradeNotRun(t *testing.T) {
+	tool := Tool
+	Tool = Module{GoMod: []byte("module fixture\n\ngo 1.22\n")}
+	defer func() { Tool = tool }()
+
+	dir := t.TempDir()
+	source := "package main\n\nfunc main() {\n\tfmt.Println(\"hi\")\n}\n"
+	if err := os.WriteFile(filepath.Join(dir, "main.go"), []byte(source), 0644); err != nil {
+		t.Fatal(err)
+	}
+	spec := Spec{Cases: []Case{{Name: "one"}, {Name: "two"}}}
+	report, err := Grade(dir, spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Built() || !strings.Contains(report.BuildError, "undefined: fmt") {
+		t.Errorf("build error %q, want one naming fmt", report.BuildError)
+	}
+	for _, r := range report.Results {
+		if r.Outcome != NotRun {
+			t.Errorf("case %s: got %s, want %s", r.Case.Name, r.Outcome, NotRun)
+		}
+	}
+	if len(report.Results) != 2 || report.Score() != 0 {
+		t.Errorf("got %d results scoring %d%%, want 2 scoring 0%%", len(report.Results), report.Score())
+	}
+}
+
+func TestDiagnose(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := Diagnose(dir); err == nil {
+		t.Error("diagnosing a missing main.go succeeded")
+	}
+
+	source := "package main\n\nfunc main() {\n\tfmt.Println(\"hi\")\n}\n"
+	if err := os.WriteFile(filepath.Join(dir, "main.go"), []byte(source), 0644); err != nil {
+		t.Fatal(err)
+	}
+	diagnostics, err := Diagnose(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := FormatDiagnostics(diagnostics)
+	if !strings.HasPrefix(got, "Your program has errors:") || !strings.Contains(got, "main.go:4:2: error:") {
+		t.Errorf("got %q, want an error at the call to fmt", got)
+	}
+	if FormatDiagnostics(nil) != "" {
+		t.Error("formatted no diagnostics as text")
+	}
+}
+
+func TestGradeContextStops(t *testing.T) {
+	tool := Tool
+	Tool = Module{GoMod: []byte("module fixture\n\ngo 1.22\n")}
+	defer func() { Tool = tool }()
+
+	dir := t.TempDir()
+	source := "package main\n\nimport \"time\"\n\nfunc main() {\n\ttime.Sleep(time.Minute)\n}\n"
+	if err := os.WriteFile(filepath.Join(dir, "main.go"), []byte(source), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// Without stopping, the cases would take their whole time limit each
+	spec := Spec{Cases: []Case{{Name: "one"}, {Name: "two"}, {Name: "three"}}}
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	start := time.Now()
+	_, err := GradeContext(ctx, dir, spec)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got error %v, want the context's", err)
+	}
+	if elapsed := time.Since(start); elapsed > DefaultLimits.Timeout {
+		t.Errorf("grading took %s after the context was done", elapsed)
+	}
+}
+
+func TestProcessLimit(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("processes are only limited on Linux")
+	}
+
+	binary := buildFixture(t, behaviors)
+	c := Case{Name: "fork", Args: []string{"spawn"}, Stdout: Match{Contains: "started all"}}
+	r := Run(binary, c)
+	if r.Passed() {
+		t.Fatalf("program started 1000 processes within a limit of %d", DefaultLimits.Processes)
+	}
+	if r.Outcome != Crashed && !strings.Contains(r.Stdout, "resource temporarily unavailable") {
+		t.Errorf("outcome %s (%s), stdout %q; want the process limit to stop it", r.Outcome, r.Message, r.Stdout)
+	}
+}
+
+func ptr(s string) *string {
+	return &s
+}
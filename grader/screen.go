@@ -0,0 +1,337 @@
+package grader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// Size of the terminal interactive programs run in
+const (
+	ScreenRows = 24
+	ScreenCols = 80
+)
+
+// errExited is returned when a program ends while text is awaited
+var errExited = errors.New("program exited")
+
+// screen is a small terminal emulator. It keeps every line written to it,
+// including those scrolled off the top, follows the cursor movements and
+// erasing that prompt libraries use, and answers cursor position queries.
+type screen struct {
+	mu         sync.Mutex
+	lines      [][]rune
+	top        int // First line of the visible screen
+	row, col   int
+	saved      [2]int
+	rows, cols int
+	pending    []byte          // Incomplete escape sequence or character
+	text       strings.Builder // Everything printed, without escapes
+	consumed   int             // Length of text already matched by expect
+	written    int
+	limit      int
+	over       bool
+	exceeded   func()
+	answers    io.Writer // Where replies to queries are sent
+	updated    chan struct{}
+}
+
+func newScreen(rows, cols int, answers io.Writer, limit int, exceeded func()) *screen {
+	return &screen{
+		lines:    [][]rune{nil},
+		rows:     rows,
+		cols:     cols,
+		answers:  answers,
+		limit:    limit,
+		exceeded: exceeded,
+		updated:  make(chan struct{}, 1),
+	}
+}
+
+// Write interprets output of the program
+func (s *screen) Write(p []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.over {
+		return len(p), nil
+	}
+	s.written += len(p)
+	if s.written > s.limit {
+		s.over = true
+		s.exceeded()
+		return len(p), nil
+	}
+
+	data := append(s.pending, p...)
+	s.pending = nil
+	for i := 0; i < len(data); {
+		n := s.interpret(data[i:])
+		if n == 0 {
+			s.pending = append([]byte(nil), data[i:]...)
+			break
+		}
+		i += n
+	}
+
+	select {
+	case s.updated <- struct{}{}:
+	default:
+	}
+	return len(p), nil
+}
+
+// interpret handles the character or escape sequence at the start of data
+// and returns its length, or 0 if it is incomplete
+func (s *screen) interpret(data []byte) int {
+	switch b := data[0]; {
+	case b == 0x1b:
+		return s.escape(data)
+	case b == '\r':
+		s.col = 0
+	case b == '\n':
+		s.moveTo(s.row+1, s.col)
+		s.text.WriteByte('\n')
+	case b == '\b':
+		s.col = max(s.col-1, 0)
+	case b == '\t':
+		s.col = min((s.col/8+1)*8, s.cols-1)
+	case b < 0x20 || b == 0x7f:
+		// Bells and other controls don't change the screen
+	default:
+		if !utf8.FullRune(data) {
+			return 0
+		}
+		r, size := utf8.DecodeRune(data)
+		s.put(r)
+		return size
+	}
+	return 1
+}
+
+// escape handles the escape sequence at the start of data
+func (s *screen) escape(data []byte) int {
+	if len(data) < 2 {
+		return 0
+	}
+	switch data[1] {
+	case '[':
+		for i := 2; i < len(data); i++ {
+			if data[i] >= 0x40 && data[i] <= 0x7e {
+				s.control(data[i], string(data[2:i]))
+				return i + 1
+			}
+		}
+		return 0
+	case ']':
+		// Operating system commands, such as setting the title, end with
+		// a bell or ESC \
+		for i := 2; i < len(data); i++ {
+			if data[i] == 0x07 {
+				return i + 1
+			}
+			if data[i] == '\\' && data[i-1] == 0x1b {
+				return i + 1
+			}
+		}
+		return 0
+	case '7':
+		s.saved = [2]int{s.row - s.top, s.col}
+	case '8':
+		s.moveTo(s.top+s.saved[0], s.saved[1])
+	case 'M':
+		s.moveTo(s.row-1, s.col)
+	}
+	return 2
+}
+
+// control handles a control sequence with its parameters
+func (s *screen) control(final byte, params string) {
+	private := strings.HasPrefix(params, "?")
+	var args []int
+	for _, param := range strings.Split(strings.TrimLeft(params, "?"), ";") {
+		n, _ := strconv.Atoi(param)
+		args = append(args, n)
+	}
+	arg := func(i, fallback int) int {
+		if i < len(args) && args[i] > 0 {
+			return args[i]
+		}
+		return fallback
+	}
+	if private {
+		// Showing the cursor and similar modes don't change the screen
+		return
+	}
+
+	switch final {
+	case 'A':
+		s.moveTo(s.row-arg(0, 1), s.col)
+	case 'B':
+		s.moveTo(min(s.row+arg(0, 1), s.top+s.rows-1), s.col)
+	case 'C':
+		s.col = min(s.col+arg(0, 1), s.cols-1)
+	case 'D':
+		s.col = max(s.col-arg(0, 1), 0)
+	case 'E':
+		s.moveTo(min(s.row+arg(0, 1), s.top+s.rows-1), 0)
+	case 'F':
+		s.moveTo(s.row-arg(0, 1), 0)
+	case 'G':
+		s.col = min(arg(0, 1), s.cols) - 1
+	case 'H', 'f':
+		s.moveTo(s.top+min(arg(0, 1), s.rows)-1, min(arg(1, 1), s.cols)-1)
+	case 'J':
+		s.eraseDisplay(arg(0, 0))
+	case 'K':
+		s.eraseLine(arg(0, 0))
+	case 'n':
+		if arg(0, 0) == 6 {
+			fmt.Fprintf(s.answers, "\x1b[%d;%dR", s.row-s.top+1, s.col+1)
+		}
+	case 's':
+		s.saved = [2]int{s.row - s.top, s.col}
+	case 'u':
+		s.moveTo(s.top+s.saved[0], s.saved[1])
+	}
+}
+
+// moveTo moves the cursor, keeping it on the visible screen and scrolling
+// when it goes past the bottom
+func (s *screen) moveTo(row, col int) {
+	row = max(row, s.top)
+	for len(s.lines) <= row {
+		s.lines = append(s.lines, nil)
+	}
+	if row >= s.top+s.rows {
+		s.top = row - s.rows + 1
+	}
+	s.row, s.col = row, col
+}
+
+// put writes a character at the cursor, wrapping at the right edge
+func (s *screen) put(r rune) {
+	if s.col >= s.cols {
+		s.moveTo(s.row+1, 0)
+	}
+	line := s.lines[s.row]
+	for len(line) <= s.col {
+		line = append(line, ' ')
+	}
+	line[s.col] = r
+	s.lines[s.row] = line
+	s.col++
+	s.text.WriteRune(r)
+}
+
+// eraseLine clears from the cursor to the end of the line, from the start
+// of the line to the cursor, or the whole line
+func (s *screen) eraseLine(mode int) {
+	line := s.lines[s.row]
+	switch mode {
+	case 0:
+		if s.col < len(line) {
+			s.lines[s.row] = line[:s.col]
+		}
+	case 1:
+		for i := 0; i <= s.col && i < len(line); i++ {
+			line[i] = ' '
+		}
+	default:
+		s.lines[s.row] = nil
+	}
+}
+
+// eraseDisplay clears from the cursor to the end of the screen, from the
+// start of the screen to the cursor, or the whole screen
+func (s *screen) eraseDisplay(mode int) {
+	switch mode {
+	case 0:
+		s.eraseLine(0)
+		s.lines = s.lines[:s.row+1]
+	case 1:
+		for i := s.top; i < s.row; i++ {
+			s.lines[i] = nil
+		}
+		s.eraseLine(1)
+	default:
+		for i := s.top; i < len(s.lines); i++ {
+			s.lines[i] = nil
+		}
+	}
+}
+
+// String returns every line of the screen without trailing spaces
+func (s *screen) String() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var lines []string
+	for _, line := range s.lines {
+		lines = append(lines, strings.TrimRight(string(line), " "))
+	}
+	return strings.TrimRight(strings.Join(lines, "\n"), "\n") + "\n"
+}
+
+// Transcript returns everything the program printed, without escapes
+func (s *screen) Transcript() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.text.String()
+}
+
+// Overflowed reports whether the program wrote more than the limit
+func (s *screen) Overflowed() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.over
+}
+
+// expect waits until the program prints text, after whatever earlier calls
+// matched. It fails when ctx is done or the output closed ends.
+func (s *screen) expect(ctx context.Context, text string, closed <-chan struct{}) error {
+	ended := false
+	for {
+		s.mu.Lock()
+		printed := s.text.String()[s.consumed:]
+		if i := strings.Index(printed, text); i >= 0 {
+			s.consumed += i + len(text)
+			s.mu.Unlock()
+			return nil
+		}
+		s.mu.Unlock()
+		if ended {
+			return errExited
+		}
+
+		select {
+		case <-s.updated:
+		case <-closed:
+			// Look once more at what came with the last write
+			ended = true
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// settle waits until the program has printed nothing for quiet, so that it
+// is ready for input, or until wait has passed
+func (s *screen) settle(quiet, wait time.Duration) {
+	deadline := time.After(wait)
+	for {
+		select {
+		case <-s.updated:
+		case <-time.After(quiet):
+			return
+		case <-deadline:
+			return
+		}
+	}
+}
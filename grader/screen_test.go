@@ -0,0 +1,148 @@
+package grader
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// The size of the screens in these tests
+const smallRows, smallCols = 3, 10
+
+func TestScreen(t *testing.T) {
+	tests := []struct {
+		name   string
+		output string
+		want   string
+	}{
+		{"lines", "hello\r\nworld\r\n", "hello\nworld\n"},
+		{"carriage return overwrites", "hello\rJ", "Jello\n"},
+		{"backspace", "ab\bc", "ac\n"},
+		{"tab", "a\tb", "a       b\n"},
+		{"cursor left and right", "abcdef\x1b[3Dx\x1b[Cy", "abcxey\n"},
+		{"column", "hello\x1b[2GX", "hXllo\n"},
+		{"up and erase line", "one\r\ntwo\x1b[A\r\x1b[2Kuno", "uno\ntwo\n"},
+		{"erase to end of line", "hello\x1b[3D\x1b[K", "he\n"},
+		{"erase to start of line", "hello\x1b[2D\x1b[1K", "    o\n"},
+		{"erase below", "a\r\nb\r\nc\x1b[2;1H\x1b[J", "a\n"},
+		{"home and clear", "a\r\nb\x1b[H\x1b[2Jc", "c\n"},
+		{"wrap", "0123456789abc", "0123456789\nabc\n"},
+		{"scrolled lines are kept", "1\r\n2\r\n3\r\n4\r\n5\x1b[H>", "1\n2\n>\n4\n5\n"},
+		{"save and restore", "ab\x1b7\r\ncd\x1b8X", "abX\ncd\n"},
+		{"save and restore with CSI", "ab\x1b[s\r\ncd\x1b[uX", "abX\ncd\n"},
+		{"reverse index", "a\r\nb\x1bMX", "aX\nb\n"},
+		{"colors", "\x1b[1;32mok\x1b[0m", "ok\n"},
+		{"modes and titles", "\x1b[?25l\x1b]0;title\x07\x1b]2;t\x1b\\hi\x1b[?25h", "hi\n"},
+		{"unicode", "héllo ✓", "héllo ✓\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scr := newScreen(smallRows, smallCols, &bytes.Buffer{}, 1<<10, func() {})
+			scr.Write([]byte(tt.output))
+			if got := scr.String(); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+
+			// Programs write escapes and characters in pieces too
+			split := newScreen(smallRows, smallCols, &bytes.Buffer{}, 1<<10, func() {})
+			for i := 0; i < len(tt.output); i++ {
+				split.Write([]byte{tt.output[i]})
+			}
+			if got := split.String(); got != tt.want {
+				t.Errorf("written a byte at a time, got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScreenCursorPosition(t *testing.T) {
+	var answers bytes.Buffer
+	scr := newScreen(smallRows, smallCols, &answers, 1<<10, func() {})
+	scr.Write([]byte("ab\x1b[6n"))
+	scr.Write([]byte("\r\n1\r\n2\r\n3\x1b[6n"))
+	if got, want := answers.String(), "\x1b[1;3R\x1b[3;2R"; got != want {
+		t.Errorf("answered %q, want %q", got, want)
+	}
+}
+
+func TestScreenTranscript(t *testing.T) {
+	scr := newScreen(smallRows, smallCols, &bytes.Buffer{}, 1<<10, func() {})
+	scr.Write([]byte("Loading\r\x1b[KDone\x1b[31m!\x1b[0m\r\nbye"))
+	if got, want := scr.Transcript(), "LoadingDone!\nbye"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestScreenLimit(t *testing.T) {
+	exceeded := 0
+	scr := newScreen(smallRows, smallCols, &bytes.Buffer{}, 10, func() { exceeded++ })
+	scr.Write([]byte("123456"))
+	if scr.Overflowed() {
+		t.Fatal("overflowed after 6 of 10 bytes")
+	}
+	scr.Write([]byte("789012"))
+	scr.Write([]byte("345678"))
+	if !scr.Overflowed() || exceeded != 1 {
+		t.Errorf("overflowed %v, exceeded called %d times; want true and once", scr.Overflowed(), exceeded)
+	}
+	if got := scr.String(); got != "123456\n" {
+		t.Errorf("got %q, want only what came before the limit", got)
+	}
+}
+
+func TestScreenExpect(t *testing.T) {
+	scr := newScreen(smallRows, smallCols, &bytes.Buffer{}, 1<<10, func() {})
+	ctx := context.Background()
+	closed := make(chan struct{})
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		scr.Write([]byte("x? x?"))
+	}()
+	for i := 0; i < 2; i++ {
+		if err := scr.expect(ctx, "x?", closed); err != nil {
+			t.Fatalf("expect %d: %s", i, err)
+		}
+	}
+
+	// Text is only matched once
+	close(closed)
+	if err := scr.expect(ctx, "x?", closed); !errors.Is(err, errExited) {
+		t.Errorf("got %v, want %v", err, errExited)
+	}
+
+	open := make(chan struct{})
+	ctx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	if err := scr.expect(ctx, "never", open); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestStepValidate(t *testing.T) {
+	tests := []struct {
+		step  Step
+		valid bool
+		text  string
+	}{
+		{Step{Expect: "Name?"}, true, `wait for "Name?"`},
+		{Step{Type: "Ada"}, true, `type "Ada"`},
+		{Step{Keys: []string{"down", "enter"}}, true, "press down, enter"},
+		{Step{}, false, ""},
+		{Step{Expect: "Name?", Type: "Ada"}, false, ""},
+		{Step{Keys: []string{"f13"}}, false, ""},
+	}
+
+	for _, tt := range tests {
+		err := tt.step.validate()
+		if (err == nil) != tt.valid {
+			t.Errorf("%+v: got error %v, want valid %v", tt.step, err, tt.valid)
+		}
+		if tt.valid && tt.step.String() != tt.text {
+			t.Errorf("%+v is described as %q, want %q", tt.step, tt.step.String(), tt.text)
+		}
+	}
+}
@@ -0,0 +1,228 @@
+package grader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Step is one thing done while a program runs in a terminal. Exactly one
+// of its fields is set.
+type Step struct {
+	Expect string   `yaml:"expect"` // Text to wait for before going on
+	Type   string   `yaml:"type"`   // Text to type
+	Keys   []string `yaml:"keys"`   // Keys to press, by name
+}
+
+// Keys are the names of the keys a step can press
+var Keys = map[string]string{
+	"enter":     "\r",
+	"space":     " ",
+	"tab":       "\t",
+	"backspace": "\x7f",
+	"escape":    "\x1b",
+	"up":        "\x1b[A",
+	"down":      "\x1b[B",
+	"right":     "\x1b[C",
+	"left":      "\x1b[D",
+	"ctrl-c":    "\x03",
+	"ctrl-d":    "\x04",
+}
+
+// How long a program has to be quiet before input is sent, and the longest
+// that is waited for, so that prompts are ready to read it
+const (
+	settleQuiet = 50 * time.Millisecond
+	settleWait  = time.Second
+)
+
+// validate checks that the step does one known thing
+func (s Step) validate() error {
+	set := 0
+	for _, field := range []bool{s.Expect != "", s.Type != "", len(s.Keys) > 0} {
+		if field {
+			set++
+		}
+	}
+	if set != 1 {
+		return errors.New("a step needs exactly one of expect, type and keys")
+	}
+	for _, key := range s.Keys {
+		if _, ok := Keys[key]; !ok {
+			return fmt.Errorf("unknown key %q, use one of %s", key, strings.Join(keyNames(), ", "))
+		}
+	}
+	return nil
+}
+
+// keyNames returns the names of the known keys in order
+func keyNames() []string {
+	var names []string
+	for name := range Keys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// String describes the step for learners
+func (s Step) String() string {
+	switch {
+	case s.Expect != "":
+		return fmt.Sprintf("wait for %q", s.Expect)
+	case s.Type != "":
+		return fmt.Sprintf("type %q", s.Type)
+	default:
+		return "press " + strings.Join(s.Keys, ", ")
+	}
+}
+
+// describeScript lists the steps of a script in one line
+func describeScript(script []Step) string {
+	var steps []string
+	for _, step := range script {
+		steps = append(steps, step.String())
+	}
+	return strings.Join(steps, ", ")
+}
+
+// runScript runs a program in a terminal, drives it through the steps of
+// c and compares the final screen with what c expects on stdout
+func runScript(ctx context.Context, binary string, c Case, limits Limits) Result {
+	result := Result{Case: c}
+
+	scratch, err := os.MkdirTemp("", "gocli-teacher-run-")
+	if err != nil {
+		result.Message = fmt.Sprintf("failed to create working directory: %s", err)
+		return result
+	}
+	defer os.RemoveAll(scratch)
+
+	ctx, cancel := context.WithTimeout(ctx, limits.Timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, binary, c.Args...)
+	cmd.Dir = scratch
+	cmd.Env = append(sandboxEnv(scratch, c.Env), "TERM=xterm")
+	cmd.Cancel = func() error {
+		return killGroup(cmd)
+	}
+	started, err := limit(cmd, limits)
+	if err != nil {
+		result.Message = err.Error()
+		return result
+	}
+
+	term, err := startTerminal(cmd, ScreenRows, ScreenCols)
+	if err != nil {
+		started()
+		result.Message = fmt.Sprintf("failed to run: %s", err)
+		return result
+	}
+	defer term.Close()
+	if err := started(); err != nil {
+		killGroup(cmd)
+		cmd.Wait()
+		result.Message = err.Error()
+		return result
+	}
+
+	scr := newScreen(ScreenRows, ScreenCols, term, limits.Output, cancel)
+	closed := make(chan struct{})
+	go func() {
+		// Reading fails once the program and everything it started exit
+		buf := make([]byte, 4096)
+		for {
+			n, err := term.Read(buf)
+			scr.Write(buf[:n])
+			if err != nil {
+				break
+			}
+		}
+		close(closed)
+	}()
+
+	// Stop at the first step that can't be done; the program is then left
+	// to finish or time out, and the reason is reported if nothing else went
+	// wrong first
+	var problem string
+	for _, step := range c.Script {
+		if step.Expect != "" {
+			err := scr.expect(ctx, step.Expect, closed)
+			if errors.Is(err, errExited) {
+				problem = fmt.Sprintf("did not show %q", step.Expect)
+				break
+			}
+			if err != nil {
+				problem = fmt.Sprintf("did not show %q within %s", step.Expect, limits.Timeout)
+				break
+			}
+			continue
+		}
+
+		input := []string{step.Type}
+		if step.Type == "" {
+			input = nil
+			for _, key := range step.Keys {
+				input = append(input, Keys[key])
+			}
+		}
+		for _, keys := range input {
+			scr.settle(settleQuiet, settleWait)
+			if _, err := term.WriteString(keys); err != nil {
+				problem = fmt.Sprintf("exited before %s", step)
+				break
+			}
+		}
+		if problem != "" {
+			break
+		}
+	}
+
+	err = cmd.Wait()
+	killGroup(cmd)
+	select {
+	case <-closed:
+	case <-time.After(time.Second):
+		// Something outside the program still holds the terminal
+	}
+	result.Stdout = scr.String()
+
+	var exitErr *exec.ExitError
+	switch {
+	case scr.Overflowed():
+		result.Outcome = TooMuchOutput
+		result.Message = fmt.Sprintf("wrote more than %d KiB of output", limits.Output>>10)
+		return result
+	case ctx.Err() != nil:
+		result.Outcome = TimedOut
+		result.Message = fmt.Sprintf("did not finish within %s", limits.Timeout)
+		if problem != "" {
+			result.Message = problem
+		}
+		return result
+	case errors.As(err, &exitErr):
+		result.ExitCode = exitErr.ExitCode()
+	case err != nil:
+		result.Message = fmt.Sprintf("failed to run: %s", err)
+		return result
+	}
+
+	// The terminal mixes stderr with stdout, so look for crashes in both
+	if crash := crashMessage(result.ExitCode, scr.Transcript(), limits); crash != "" {
+		result.Outcome = Crashed
+		result.Message = crash
+		return result
+	}
+	if problem != "" {
+		result.Message = problem
+		return result
+	}
+	result.check()
+	return result
+}
@@ -0,0 +1,111 @@
+package grader
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// prompter is a program that asks for a name on its terminal
+const prompter = `package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+)
+
+func main() {
+	if info, _ := os.Stdin.Stat(); info.Mode()&os.ModeCharDevice == 0 {
+		fmt.Println("not a terminal")
+		os.Exit(1)
+	}
+	switch os.Args[1] {
+	case "greet":
+		fmt.Print("Name? ")
+		name, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil {
+			fmt.Println("no name")
+			os.Exit(1)
+		}
+		fmt.Printf("Hello, %s!\n", strings.TrimSpace(name))
+	case "bye":
+		fmt.Println("bye")
+	case "panic":
+		fmt.Print("Name? ")
+		panic("boom")
+	case "flood":
+		for {
+			fmt.Println("spam spam spam spam spam spam spam")
+		}
+	}
+}
+`
+
+func TestRunScript(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("programs only run in a terminal on Unix")
+	}
+	binary := buildFixture(t, prompter)
+	greet := []Step{{Expect: "Name?"}, {Type: "Ada"}, {Keys: []string{"enter"}}}
+
+	tests := []struct {
+		name    string
+		c       Case
+		outcome Outcome
+		message string // Text the message has to contain
+	}{
+		{
+			name: "answers the prompt",
+			c: Case{Args: []string{"greet"}, Script: append(greet, Step{Expect: "Hello, Ada!"}),
+				Stdout: Match{Equals: ptr("Name? Ada\nHello, Ada!\n")}},
+			outcome: Passed,
+		},
+		{
+			name: "end of input",
+			c: Case{Args: []string{"greet"}, Script: []Step{{Expect: "Name?"}, {Keys: []string{"ctrl-d"}}},
+				ExitCode: 1, Stdout: Match{Contains: "no name"}},
+			outcome: Passed,
+		},
+		{
+			name:    "wrong screen",
+			c:       Case{Args: []string{"greet"}, Script: greet, Stdout: Match{Contains: "Goodbye"}},
+			outcome: Failed,
+			message: `output does not contain "Goodbye"`,
+		},
+		{
+			name:    "exits without the text",
+			c:       Case{Args: []string{"bye"}, Script: greet},
+			outcome: Failed,
+			message: `did not show "Name?"`,
+		},
+		{
+			name:    "waits without the text",
+			c:       Case{Args: []string{"greet"}, Script: []Step{{Expect: "Password:"}}},
+			outcome: TimedOut,
+			message: `did not show "Password:" within 2s`,
+		},
+		{
+			name:    "crash",
+			c:       Case{Args: []string{"panic"}, Script: greet},
+			outcome: Crashed,
+			message: "panic: boom",
+		},
+		{
+			name:    "output cap",
+			c:       Case{Args: []string{"flood"}, Script: []Step{{Expect: "never"}}},
+			outcome: TooMuchOutput,
+			message: "wrote more than 64 KiB",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := RunWithin(binary, tt.c, quick)
+			if r.Outcome != tt.outcome || !strings.Contains(r.Message, tt.message) {
+				t.Errorf("got %s (%s), want %s (%s)\nscreen: %.300q", r.Outcome, r.Message, tt.outcome, tt.message, r.Stdout)
+			}
+		})
+	}
+}
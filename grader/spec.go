@@ -0,0 +1,234 @@
+package grader
+
+import (
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Spec lists the test cases of an exercise and the rules its code has to follow
+type Spec struct {
+	Cases  []Case `yaml:"cases"`
+	Rubric []Rule `yaml:"rubric"`
+}
+
+// Case is a run of a program and the behavior expected from it
+type Case struct {
+	Name        string            `yaml:"name"`
+	Description string            `yaml:"description"` // What the output should be, in words, when the match is not readable
+	Args        []string          `yaml:"args"`        // Arguments passed to the program
+	Stdin       string            `yaml:"stdin"`       // Input, closed when empty
+	Env         map[string]string `yaml:"env"`         // Variables set besides PATH, HOME and TMPDIR
+	Stdout      Match             `yaml:"stdout"`
+	Stderr      Match             `yaml:"stderr"`
+	ExitCode    int               `yaml:"exit_code"`
+	Script      []Step            `yaml:"script"` // Input for a program run in a terminal; stdout is then the final screen
+	Hint        string            `yaml:"hint"`   // Shown when the case fails
+}
+
+// Match is what a program must print on an output stream. Only the fields
+// that are set are checked.
+type Match struct {
+	Equals   *string `yaml:"equals"`   // The whole output
+	Contains string  `yaml:"contains"` // Text the output must contain
+	Matches  string  `yaml:"matches"`  // Regular expression the output must match
+
+	pattern *regexp.Regexp
+}
+
+// UnmarshalYAML accepts a plain string as text the output must contain
+func (m *Match) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		m.Contains = value.Value
+		return nil
+	}
+
+	type plain Match
+	return value.Decode((*plain)(m))
+}
+
+// compile checks and compiles the regular expression of the match
+func (m *Match) compile() error {
+	if m.Matches == "" {
+		return nil
+	}
+	pattern, err := regexp.Compile(m.Matches)
+	if err != nil {
+		return fmt.Errorf("invalid pattern %q: %w", m.Matches, err)
+	}
+	m.pattern = pattern
+	return nil
+}
+
+// empty reports whether the match accepts any output
+func (m Match) empty() bool {
+	return m.Equals == nil && m.Contains == "" && m.Matches == ""
+}
+
+// check returns why output does not match, or "" if it does
+func (m Match) check(output string) string {
+	output = strings.ReplaceAll(output, "\r\n", "\n")
+	switch {
+	case m.Equals != nil && output != *m.Equals:
+		return fmt.Sprintf("is %q, want %q", output, *m.Equals)
+	case !strings.Contains(output, m.Contains):
+		return fmt.Sprintf("does not contain %q", m.Contains)
+	case m.pattern != nil && !m.pattern.MatchString(output):
+		return fmt.Sprintf("does not match %q", m.Matches)
+	}
+	return ""
+}
+
+// expected describes the output a match accepts
+func (m Match) expected() string {
+	switch {
+	case m.Equals != nil:
+		return strings.TrimRight(*m.Equals, "\n")
+	case m.Contains != "":
+		return m.Contains
+	case m.Matches != "":
+		return fmt.Sprintf("output matching %s", m.Matches)
+	}
+	return ""
+}
+
+// Empty reports whether the spec has nothing to grade a program with
+func (s Spec) Empty() bool {
+	return len(s.Cases) == 0 && len(s.Rubric) == 0
+}
+
+// Prepare checks the cases and rules and compiles the patterns of the cases
+func (s *Spec) Prepare() error {
+	rules := make(map[string]bool)
+	for _, rule := range s.Rubric {
+		if err := rule.validate(); err != nil {
+			return err
+		}
+		if rules[rule.Name] {
+			return fmt.Errorf("duplicate rule %q", rule.Name)
+		}
+		rules[rule.Name] = true
+	}
+
+	names := make(map[string]bool)
+	for i := range s.Cases {
+		c := &s.Cases[i]
+		if c.Name == "" {
+			return fmt.Errorf("test case %d is missing a name", i+1)
+		}
+		if names[c.Name] {
+			return fmt.Errorf("duplicate test case %q", c.Name)
+		}
+		names[c.Name] = true
+
+		if len(c.Script) > 0 {
+			// A terminal has a single output, and input comes from the script
+			if c.Stdin != "" || !c.Stderr.empty() {
+				return fmt.Errorf("test case %q: a case with a script can't have stdin or stderr", c.Name)
+			}
+			for j, step := range c.Script {
+				if err := step.validate(); err != nil {
+					return fmt.Errorf("test case %q: step %d: %w", c.Name, j+1, err)
+				}
+			}
+		}
+
+		if err := c.Stdout.compile(); err != nil {
+			return fmt.Errorf("test case %q: stdout: %w", c.Name, err)
+		}
+		if err := c.Stderr.compile(); err != nil {
+			return fmt.Errorf("test case %q: stderr: %w", c.Name, err)
+		}
+	}
+	return nil
+}
+
+// LoadSpec reads, parses and prepares a spec from the given file system
+func LoadSpec(fsys fs.FS, path string) (*Spec, error) {
+	data, err := fs.ReadFile(fsys, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read test spec: %w", err)
+	}
+
+	var spec Spec
+	if err := yaml.Unmarshal(data, &spec); err != nil {
+		return nil, fmt.Errorf("%s: failed to parse test spec: %w", path, err)
+	}
+	if err := spec.Prepare(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return &spec, nil
+}
+
+// Command returns the command line that runs a case with go run
+func (c Case) Command() string {
+	var names []string
+	for name := range c.Env {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var sb strings.Builder
+	for _, name := range names {
+		sb.WriteString(fmt.Sprintf("%s=%s ", name, quoteArg(c.Env[name])))
+	}
+	sb.WriteString("go run main.go")
+	for _, arg := range c.Args {
+		sb.WriteString(" " + quoteArg(arg))
+	}
+	if c.Stdin != "" {
+		sb.WriteString(fmt.Sprintf(" <<< %s", quoteArg(strings.TrimRight(c.Stdin, "\n"))))
+	}
+	return sb.String()
+}
+
+// quoteArg quotes an argument for a shell if it needs it
+func quoteArg(arg string) string {
+	if arg != "" && !strings.ContainsAny(arg, " \t\n'\"\\$`!*?&|;<>()") {
+		return arg
+	}
+	return "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+}
+
+// Expected describes what a case expects the program to do
+func (c Case) Expected() string {
+	var parts []string
+	if c.Description != "" {
+		parts = append(parts, c.Description)
+	} else {
+		if out := c.Stdout.expected(); out != "" {
+			parts = append(parts, out)
+		}
+		if out := c.Stderr.expected(); out != "" {
+			parts = append(parts, fmt.Sprintf("%s on stderr", out))
+		}
+	}
+	if c.ExitCode != 0 {
+		parts = append(parts, fmt.Sprintf("exit status %d", c.ExitCode))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// FormatCases lists the cases as commands to try and what they should print
+func FormatCases(cases []Case) string {
+	var sb strings.Builder
+	for i, c := range cases {
+		sb.WriteString(fmt.Sprintf("%d. %s:\n", i+1, c.Name))
+		sb.WriteString(fmt.Sprintf("   %s\n", c.Command()))
+		if len(c.Script) > 0 {
+			sb.WriteString(fmt.Sprintf("   Then: %s\n", describeScript(c.Script)))
+		}
+		if expected := c.Expected(); expected != "" {
+			sb.WriteString(fmt.Sprintf("   Expected: %s\n", expected))
+		}
+		if i < len(cases)-1 {
+			sb.WriteString("\n")
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,90 @@
+package lessons
+
+import (
+	"fmt"
+	"gocli-teacher/quiz"
+	"io/fs"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Lesson describes a tutorial as a series of pages followed by a quiz
+type Lesson struct {
+	ID            string    `yaml:"id"`
+	Name          string    `yaml:"name"`    // Name used on the command line, defaults to the ID
+	Aliases       []string  `yaml:"aliases"` // Other names accepted on the command line
+	Title         string    `yaml:"title"`
+	Description   string    `yaml:"description"`
+	Version       string    `yaml:"version"`        // Recorded with quiz answers, change it when the lesson changes
+	Order         int       `yaml:"order"`          // Position in tutorial listings
+	Prerequisites []string  `yaml:"prerequisites"`  // IDs of tutorials or exercises to finish first
+	PassThreshold int       `yaml:"pass_threshold"` // Correct answers needed to complete the lesson
+	Pages         []Page    `yaml:"pages"`
+	Quiz          quiz.Quiz `yaml:"quiz"`
+	Closing       string    `yaml:"closing"` // Shown on the final screen after the score
+}
+
+// Page is a single screen of a lesson. The screen is cleared and the
+// lesson title is shown before its steps are played.
+type Page struct {
+	Steps []Step `yaml:"steps"`
+}
+
+// Step is one action on a page. Only one of its fields is expected to be set.
+type Step struct {
+	Text    string        `yaml:"text,omitempty"`    // Printed as-is
+	Code    string        `yaml:"code,omitempty"`    // Printed as a code block
+	Listing string        `yaml:"listing,omitempty"` // Printed as a code block with line numbers
+	Pause   bool          `yaml:"pause,omitempty"`   // Waits for the user to press Enter
+	Wait    time.Duration `yaml:"wait,omitempty"`    // Sleeps for the given duration
+}
+
+// Parse decodes a lesson from YAML and validates it
+func Parse(data []byte) (*Lesson, error) {
+	var lesson Lesson
+	if err := yaml.Unmarshal(data, &lesson); err != nil {
+		return nil, fmt.Errorf("failed to parse lesson: %w", err)
+	}
+
+	if err := lesson.validate(); err != nil {
+		return nil, err
+	}
+
+	return &lesson, nil
+}
+
+// Load reads and parses a lesson file from the given file system
+func Load(fsys fs.FS, path string) (*Lesson, error) {
+	data, err := fs.ReadFile(fsys, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read lesson: %w", err)
+	}
+
+	lesson, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return lesson, nil
+}
+
+// validate checks that the lesson can be played back
+func (l *Lesson) validate() error {
+	if l.ID == "" {
+		return fmt.Errorf("lesson is missing an id")
+	}
+	if l.Title == "" {
+		return fmt.Errorf("lesson %s is missing a title", l.ID)
+	}
+	if l.PassThreshold > len(l.Quiz.Questions) {
+		return fmt.Errorf("lesson %s has a pass threshold of %d but only %d questions",
+			l.ID, l.PassThreshold, len(l.Quiz.Questions))
+	}
+
+	if err := l.Quiz.Prepare(l.ID); err != nil {
+		return fmt.Errorf("lesson %s: %w", l.ID, err)
+	}
+
+	return nil
+}
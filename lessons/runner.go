@@ -0,0 +1,64 @@
+package lessons
+
+import (
+	"fmt"
+	"gocli-teacher/quiz"
+	"gocli-teacher/utils"
+	"time"
+)
+
+// Run plays back a lesson. It returns true if the quiz was passed,
+// along with the answer to each question.
+func Run(lesson *Lesson) (bool, quiz.Results) {
+	for _, page := range lesson.Pages {
+		ShowPage(lesson.Title, page)
+		utils.PressEnterToContinue()
+	}
+
+	results := runQuiz(lesson)
+
+	utils.ClearScreen()
+	utils.PrintTitle(lesson.Title)
+
+	// Final score
+	fmt.Printf("You got %d out of %d questions correct!\n\n", results.Correct, results.Total)
+	fmt.Print(lesson.Closing)
+
+	utils.PressEnterToContinue()
+
+	return results.Correct >= lesson.PassThreshold, results
+}
+
+// ShowPage clears the screen, shows the title and plays the steps of a page
+func ShowPage(title string, page Page) {
+	utils.ClearScreen()
+	utils.PrintTitle(title)
+
+	for _, step := range page.Steps {
+		runStep(step)
+	}
+}
+
+// runStep performs a single page step
+func runStep(step Step) {
+	switch {
+	case step.Text != "":
+		fmt.Print(step.Text)
+	case step.Code != "":
+		utils.PrintCodeBlock(step.Code)
+	case step.Listing != "":
+		utils.PrintCodeWithLineNumbers(step.Listing)
+	case step.Pause:
+		utils.PressEnterToContinue()
+	case step.Wait > 0:
+		time.Sleep(step.Wait)
+	}
+}
+
+// runQuiz asks the lesson's quiz questions and returns the results
+func runQuiz(lesson *Lesson) quiz.Results {
+	utils.ClearScreen()
+	utils.PrintTitle(lesson.Title)
+
+	return quiz.Run(lesson.Quiz)
+}
@@ -0,0 +1,56 @@
+package lint
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/importer"
+	"go/token"
+	"go/types"
+	"io"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+)
+
+// newImporter returns an importer that reads compiled export data for the
+// given packages and their dependencies. The export data is located with
+// 'go list' in dir, which builds it if needed.
+func newImporter(fset *token.FileSet, dir string, imports map[string]bool) (types.Importer, error) {
+	exports := make(map[string]string)
+	if len(imports) > 0 {
+		var paths []string
+		for path := range imports {
+			paths = append(paths, path)
+		}
+		sort.Strings(paths)
+
+		args := append([]string{"list", "-e", "-export", "-deps", "-f", "{{.ImportPath}}={{.Export}}"}, paths...)
+		cmd := exec.Command("go", args...)
+		cmd.Dir = dir
+		var stderr bytes.Buffer
+		cmd.Stderr = &stderr
+		out, err := cmd.Output()
+		if err != nil {
+			return nil, fmt.Errorf("failed to locate packages with go list: %w\n%s", err, stderr.String())
+		}
+
+		scanner := bufio.NewScanner(bytes.NewReader(out))
+		for scanner.Scan() {
+			path, export, _ := strings.Cut(scanner.Text(), "=")
+			if export != "" {
+				exports[path] = export
+			}
+		}
+	}
+
+	lookup := func(path string) (io.ReadCloser, error) {
+		export, ok := exports[path]
+		if !ok {
+			return nil, fmt.Errorf("package %s is not available; it may be missing from the module in %s", path, dir)
+		}
+		return os.Open(export)
+	}
+	return importer.ForCompiler(fset, "gc", lookup), nil
+}
@@ -0,0 +1,321 @@
+// Package lint parses and type-checks the Go code shipped with lessons
+// and exercises.
+package lint
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"go/types"
+	"io/fs"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Snippet is a piece of Go code to check
+type Snippet struct {
+	File string // File the code comes from
+	Name string // Constant or field holding the code
+	Line int    // Line of File where the code starts
+	Code string
+
+	// Template is starting code for the learner, so the imports it
+	// declares for them are not reported as unused
+	Template bool
+}
+
+// Problem is an error found in a snippet
+type Problem struct {
+	File    string
+	Name    string
+	Line    int // Line in File
+	Column  int // Column in the snippet's code
+	Message string
+}
+
+// String formats the problem as file:line:column: name: message
+func (p Problem) String() string {
+	return fmt.Sprintf("%s:%d:%d: %s: %s", p.File, p.Line, p.Column, p.Name, p.Message)
+}
+
+// knownPackages maps package names to import paths. Fragments of code do
+// not have import declarations, so these are added for the packages they use.
+var knownPackages = map[string]string{
+	"bufio":       "bufio",
+	"bytes":       "bytes",
+	"context":     "context",
+	"errors":      "errors",
+	"exec":        "os/exec",
+	"filepath":    "path/filepath",
+	"flag":        "flag",
+	"fmt":         "fmt",
+	"io":          "io",
+	"json":        "encoding/json",
+	"log":         "log",
+	"os":          "os",
+	"signal":      "os/signal",
+	"strconv":     "strconv",
+	"strings":     "strings",
+	"testing":     "testing",
+	"time":        "time",
+	"cobra":       "github.com/spf13/cobra",
+	"survey":      "github.com/AlecAivazis/survey/v2",
+	"progressbar": "github.com/schollz/progressbar/v3",
+	"tablewriter": "github.com/olekukonko/tablewriter",
+	"renderer":    "github.com/olekukonko/tablewriter/renderer",
+	"tw":          "github.com/olekukonko/tablewriter/tw",
+}
+
+// fragmentErrors are type errors expected in fragments, which use names
+// declared elsewhere in the lesson and declare names used later on
+var fragmentErrors = []string{
+	"undefined:",
+	"declared and not used",
+	"is not used",
+	"missing return",
+}
+
+// kind is how a snippet is wrapped so it can be parsed as a file
+type kind int
+
+const (
+	program      kind = iota // A complete file with a package clause
+	declarations             // Top-level declarations
+	statements               // Statements, checked as the body of a function
+)
+
+// parsed is a snippet turned into a Go file
+type parsed struct {
+	snippet Snippet
+	kind    kind
+	file    *ast.File
+	offset  int // Lines added in front of the snippet's code
+}
+
+// Check parses and type-checks snippets. Imports are resolved with the go
+// command in dir, which has to be inside a module that requires the
+// packages the snippets import.
+func Check(snippets []Snippet, dir string) ([]Problem, error) {
+	fset := token.NewFileSet()
+
+	var problems []Problem
+	var files []*parsed
+	for _, s := range snippets {
+		p, offset, err := parse(fset, s)
+		if err != nil {
+			problems = append(problems, syntaxProblems(s, offset, err)...)
+			continue
+		}
+		files = append(files, p)
+	}
+
+	imports := make(map[string]bool)
+	for _, p := range files {
+		for _, spec := range p.file.Imports {
+			path, _ := strconv.Unquote(spec.Path.Value)
+			imports[path] = true
+		}
+	}
+	imp, err := newImporter(fset, dir, imports)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range files {
+		problems = append(problems, typeCheck(fset, imp, p)...)
+	}
+
+	sort.SliceStable(problems, func(i, j int) bool {
+		if problems[i].File != problems[j].File {
+			return problems[i].File < problems[j].File
+		}
+		return problems[i].Line < problems[j].Line
+	})
+	return problems, nil
+}
+
+// parse turns a snippet into a file, wrapping it as declarations or as
+// the body of a function if it is not a complete file. If it cannot be
+// parsed, it returns the error and the number of lines added in front of
+// the snippet's code.
+func parse(fset *token.FileSet, s Snippet) (*parsed, int, error) {
+	filename := s.File + ":" + s.Name
+
+	file, err := parser.ParseFile(fset, filename, s.Code, parser.ParseComments)
+	if err == nil {
+		return &parsed{snippet: s, kind: program, file: file}, 0, nil
+	}
+	if strings.HasPrefix(stripComments(s.Code), "package ") {
+		// A complete file with a syntax error
+		return nil, 0, err
+	}
+
+	file, declErr := parser.ParseFile(fset, filename, "package snippet\n"+s.Code, 0)
+	if declErr == nil {
+		p, err := withImports(fset, &parsed{snippet: s, kind: declarations, file: file, offset: 1})
+		return p, 1, err
+	}
+
+	file, err = parser.ParseFile(fset, filename, "package snippet\nfunc _() {\n"+s.Code+"\n}\n", 0)
+	if err != nil {
+		// Report the errors of whichever wrapping got further into the code
+		if errorLine(declErr)-1 >= errorLine(err)-2 {
+			return nil, 1, declErr
+		}
+		return nil, 2, err
+	}
+	p, err := withImports(fset, &parsed{snippet: s, kind: statements, file: file, offset: 2})
+	return p, 2, err
+}
+
+// errorLine returns the line of the first error from parsing a file
+func errorLine(err error) int {
+	var list scanner.ErrorList
+	if errors.As(err, &list) && len(list) > 0 {
+		return list[0].Pos.Line
+	}
+	return 0
+}
+
+// withImports reparses a fragment with imports for the known packages it uses
+func withImports(fset *token.FileSet, p *parsed) (*parsed, error) {
+	declared := make(map[string]bool)
+	ast.Inspect(p.file, func(n ast.Node) bool {
+		if ident, ok := n.(*ast.Ident); ok && ident.Obj != nil {
+			declared[ident.Name] = true
+		}
+		return true
+	})
+
+	used := make(map[string]bool)
+	ast.Inspect(p.file, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if x, ok := sel.X.(*ast.Ident); ok && !declared[x.Name] && knownPackages[x.Name] != "" {
+				used[x.Name] = true
+			}
+		}
+		return true
+	})
+	if len(used) == 0 {
+		return p, nil
+	}
+
+	var names []string
+	for name := range used {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// Imports go on the package clause line so that line numbers do not move
+	var imports strings.Builder
+	for _, name := range names {
+		fmt.Fprintf(&imports, "import %s %q;", name, knownPackages[name])
+	}
+
+	src := "package snippet;" + imports.String() + "\n"
+	if p.kind == statements {
+		src += "func _() {\n" + p.snippet.Code + "\n}\n"
+	} else {
+		src += p.snippet.Code
+	}
+
+	file, err := parser.ParseFile(fset, p.snippet.File+":"+p.snippet.Name, src, 0)
+	if err != nil {
+		return nil, err
+	}
+	p.file = file
+	return p, nil
+}
+
+// typeCheck type-checks a parsed snippet
+func typeCheck(fset *token.FileSet, imp types.Importer, p *parsed) []Problem {
+	var problems []Problem
+	conf := types.Config{
+		Importer: imp,
+		Error: func(err error) {
+			var typeErr types.Error
+			if !errors.As(err, &typeErr) {
+				problems = append(problems, problem(p.snippet, 0, 0, err.Error()))
+				return
+			}
+			if p.kind != program && expectedInFragment(typeErr.Msg) {
+				return
+			}
+			if p.snippet.Template && isUnusedImport(typeErr.Msg) {
+				return
+			}
+			pos := fset.Position(typeErr.Pos)
+			problems = append(problems, problem(p.snippet, pos.Line-p.offset, pos.Column, typeErr.Msg))
+		},
+	}
+	conf.Check(p.file.Name.Name, fset, []*ast.File{p.file}, nil)
+	return problems
+}
+
+// expectedInFragment reports whether a type error is expected in a fragment
+func expectedInFragment(msg string) bool {
+	for _, expected := range fragmentErrors {
+		if strings.Contains(msg, expected) {
+			return true
+		}
+	}
+	return false
+}
+
+// syntaxProblems converts the errors from parsing a snippet that had
+// offset lines added in front of its code
+func syntaxProblems(s Snippet, offset int, err error) []Problem {
+	var list scanner.ErrorList
+	if errors.As(err, &list) {
+		var problems []Problem
+		for _, e := range list {
+			problems = append(problems, problem(s, e.Pos.Line-offset, e.Pos.Column, e.Msg))
+		}
+		return problems
+	}
+	return []Problem{problem(s, 0, 0, err.Error())}
+}
+
+// problem creates a problem at a line of a snippet's code
+func problem(s Snippet, line, column int, message string) Problem {
+	if line < 1 {
+		line = 1
+	}
+	return Problem{
+		File:    s.File,
+		Name:    s.Name,
+		Line:    s.Line + line - 1,
+		Column:  column,
+		Message: message,
+	}
+}
+
+// stripComments removes leading blank and comment lines so the package clause can be found
+func stripComments(code string) string {
+	lines := strings.Split(code, "\n")
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if trimmed != "" && !strings.HasPrefix(trimmed, "//") {
+			return strings.TrimSpace(strings.Join(lines[i:], "\n"))
+		}
+	}
+	return ""
+}
+
+// Content checks the Go code in the tutorials and exercises of contentFS
+func Content(contentFS fs.FS, dir string) ([]Problem, error) {
+	snippets, err := ContentSnippets(contentFS)
+	if err != nil {
+		return nil, err
+	}
+	return Check(snippets, dir)
+}
+
+// isUnusedImport reports whether a type error is about an unused import
+func isUnusedImport(msg string) bool {
+	return strings.Contains(msg, " imported ") && strings.HasSuffix(msg, " not used")
+}
@@ -0,0 +1,52 @@
+package lint
+
+import (
+	"gocli-teacher/content"
+	"testing"
+)
+
+func TestBuiltinContent(t *testing.T) {
+	problems, err := Content(content.Builtin(), ".")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range problems {
+		t.Error(p)
+	}
+}
+
+func TestCheckReportsLines(t *testing.T) {
+	snippets := []Snippet{
+		{File: "program.go", Name: "program", Line: 10, Code: "package main\n\nfunc main() {\n\tx := 1\n}\n"},
+		{File: "lesson.yaml", Name: "statements", Line: 20, Code: "name := \"\"\nfmt.Println(name, )\nfmt.Println(1 + \"a\")"},
+		{File: "lesson.yaml", Name: "declarations", Line: 30, Code: "func greet() {\n\treturn 1\n}"},
+		{File: "lesson.yaml", Name: "syntax", Line: 40, Code: "func greet() {\n\tfmt.Println(\n}"},
+		{File: "starter.go", Name: "template", Line: 50, Code: "package main\n\nimport \"fmt\"\n\nfunc main() {}\n", Template: true},
+	}
+
+	problems, err := Check(snippets, ".")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]int{
+		"program":      13,
+		"statements":   22,
+		"declarations": 31,
+		"syntax":       42,
+	}
+	got := make(map[string]int)
+	for _, p := range problems {
+		if _, ok := got[p.Name]; !ok {
+			got[p.Name] = p.Line
+		}
+	}
+	for name, line := range want {
+		if got[name] != line {
+			t.Errorf("%s: first problem on line %d, want %d (problems: %v)", name, got[name], line, problems)
+		}
+	}
+	if _, ok := got["template"]; ok {
+		t.Errorf("template: unused import reported: %v", problems)
+	}
+}
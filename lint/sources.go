@@ -0,0 +1,91 @@
+package lint
+
+import (
+	"fmt"
+	"io/fs"
+
+	"gopkg.in/yaml.v3"
+)
+
+// codeKeys are the lesson and exercise fields that hold Go code
+var codeKeys = map[string]bool{
+	"code":     true,
+	"listing":  true,
+	"template": true,
+	"solution": true,
+}
+
+// ContentSnippets returns the Go code in every tutorial and exercise file of
+// fsys. Quiz questions are skipped, since their code may be wrong on purpose.
+func ContentSnippets(fsys fs.FS) ([]Snippet, error) {
+	var files []string
+	for _, pattern := range []string{"tutorials/*.yaml", "exercises/*.yaml"} {
+		matches, err := fs.Glob(fsys, pattern)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, matches...)
+	}
+
+	var snippets []Snippet
+	for _, file := range files {
+		data, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", file, err)
+		}
+
+		var doc yaml.Node
+		if err := yaml.Unmarshal(data, &doc); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", file, err)
+		}
+		snippets = append(snippets, yamlSnippets(file, "", &doc)...)
+	}
+	return snippets, nil
+}
+
+// yamlSnippets walks a YAML node and returns the code fields below it
+func yamlSnippets(file, name string, node *yaml.Node) []Snippet {
+	var snippets []Snippet
+	switch node.Kind {
+	case yaml.DocumentNode:
+		for _, child := range node.Content {
+			snippets = append(snippets, yamlSnippets(file, name, child)...)
+		}
+	case yaml.SequenceNode:
+		for i, child := range node.Content {
+			snippets = append(snippets, yamlSnippets(file, fmt.Sprintf("%s[%d]", name, i), child)...)
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i].Value, node.Content[i+1]
+			if key == "quiz" {
+				continue
+			}
+			field := key
+			if name != "" {
+				field = name + "." + key
+			}
+			if codeKeys[key] && value.Kind == yaml.ScalarNode {
+				snippets = append(snippets, Snippet{
+					File:     file,
+					Name:     field,
+					Line:     scalarLine(value),
+					Code:     value.Value,
+					Template: key == "template",
+				})
+				continue
+			}
+			snippets = append(snippets, yamlSnippets(file, field, value)...)
+		}
+	}
+	return snippets
+}
+
+// scalarLine returns the line where the text of a scalar starts. Block
+// scalars start on the line after their | or > indicator.
+func scalarLine(node *yaml.Node) int {
+	if node.Style&(yaml.LiteralStyle|yaml.FoldedStyle) != 0 {
+		return node.Line + 1
+	}
+	return node.Line
+}
@@ -1,13 +1,27 @@
 package main
 
 import (
+	_ "embed"
 	"fmt"
 	"os"
 
 	"gocli-teacher/cmd"
+	"gocli-teacher/grader"
 )
 
+// Learner programs are built against the same dependency versions as the
+// tool itself, so go.mod and go.sum are embedded and copied into each build
+// directory.
+//
+//go:embed go.mod
+var goMod []byte
+
+//go:embed go.sum
+var goSum []byte
+
 func main() {
+	grader.Tool = grader.Module{GoMod: goMod, GoSum: goSum}
+
 	if err := cmd.RootCmd.Execute(); err != nil {
 		fmt.Println(err)
 		os.Exit(1)
@@ -0,0 +1,77 @@
+package packs
+
+import (
+	"fmt"
+	"io/fs"
+	"regexp"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ManifestFile is the name of the manifest at the root of every pack
+const ManifestFile = "pack.yaml"
+
+// namePattern restricts pack names to safe directory names
+var namePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// Manifest describes a content pack
+type Manifest struct {
+	Name           string       `yaml:"name"`
+	Version        string       `yaml:"version"`
+	Description    string       `yaml:"description"`
+	MinToolVersion string       `yaml:"min_tool_version"` // Oldest gocli-teacher release the pack works with
+	Dependencies   []Dependency `yaml:"dependencies"`
+}
+
+// Dependency is another pack that must be installed first
+type Dependency struct {
+	Name       string `yaml:"name"`
+	MinVersion string `yaml:"min_version"`
+}
+
+// LoadManifest reads and validates the manifest at the root of fsys
+func LoadManifest(fsys fs.FS) (*Manifest, error) {
+	data, err := fs.ReadFile(fsys, ManifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", ManifestFile, err)
+	}
+
+	var m Manifest
+	if err := yaml.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", ManifestFile, err)
+	}
+
+	if err := m.validate(); err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+// validate checks the manifest's required fields and version numbers
+func (m *Manifest) validate() error {
+	if !namePattern.MatchString(m.Name) {
+		return fmt.Errorf("invalid pack name %q: use lowercase letters, digits, '-' and '_'", m.Name)
+	}
+	if _, err := parseVersion(m.Version); err != nil {
+		return fmt.Errorf("pack %s: %w", m.Name, err)
+	}
+	if m.MinToolVersion != "" {
+		if _, err := parseVersion(m.MinToolVersion); err != nil {
+			return fmt.Errorf("pack %s: min_tool_version: %w", m.Name, err)
+		}
+	}
+
+	for _, dep := range m.Dependencies {
+		if dep.Name == "" {
+			return fmt.Errorf("pack %s has a dependency without a name", m.Name)
+		}
+		if dep.MinVersion != "" {
+			if _, err := parseVersion(dep.MinVersion); err != nil {
+				return fmt.Errorf("pack %s: dependency %s: %w", m.Name, dep.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
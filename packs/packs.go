@@ -0,0 +1,335 @@
+package packs
+
+import (
+	"errors"
+	"fmt"
+	"gocli-teacher/exercises"
+	"gocli-teacher/lessons"
+	"gocli-teacher/paths"
+	"gocli-teacher/quiz"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Pack is an installed content pack
+type Pack struct {
+	Manifest
+	Dir string // Directory the pack is installed in
+}
+
+// Dir returns the directory packs are installed into
+func Dir(configDir string) string {
+	return filepath.Join(configDir, "packs")
+}
+
+// List returns the packs installed in dir, with dependencies ahead of the
+// packs that need them. Packs with a broken manifest are skipped and
+// reported in the returned error.
+func List(dir string) ([]Pack, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read packs directory: %w", err)
+	}
+
+	var packs []Pack
+	var errs []error
+	for _, entry := range entries {
+		// Skip files and in-progress installs
+		if !entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+
+		packDir := filepath.Join(dir, entry.Name())
+		m, err := LoadManifest(os.DirFS(packDir))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", entry.Name(), err))
+			continue
+		}
+		packs = append(packs, Pack{Manifest: *m, Dir: packDir})
+	}
+
+	return dependencyOrder(packs), errors.Join(errs...)
+}
+
+// Install installs the pack at src, which may be a directory, a .zip or a
+// .tar.gz archive. An installed pack with the same name is replaced.
+func Install(dir, src, toolVersion string) (*Pack, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create packs directory: %w", err)
+	}
+
+	// Unpack next to the installed packs so the final move is a rename
+	staging, err := os.MkdirTemp(dir, ".install-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create staging directory: %w", err)
+	}
+	defer os.RemoveAll(staging)
+
+	if err := unpack(src, staging); err != nil {
+		return nil, err
+	}
+
+	root, err := findRoot(staging)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := LoadManifest(os.DirFS(root))
+	if err != nil {
+		return nil, err
+	}
+
+	if m.MinToolVersion != "" && !atLeast(toolVersion, m.MinToolVersion) {
+		return nil, fmt.Errorf("pack %s requires gocli-teacher %s or later (this is %s)",
+			m.Name, m.MinToolVersion, toolVersion)
+	}
+
+	installed, _ := List(dir)
+	if err := checkDependencies(m, installed); err != nil {
+		return nil, err
+	}
+	if err := checkDependents(m, installed); err != nil {
+		return nil, err
+	}
+
+	if err := validateContent(os.DirFS(root)); err != nil {
+		return nil, fmt.Errorf("pack %s has invalid content: %w", m.Name, err)
+	}
+
+	dest := filepath.Join(dir, m.Name)
+	if err := replace(dir, dest, root); err != nil {
+		return nil, err
+	}
+
+	return &Pack{Manifest: *m, Dir: dest}, nil
+}
+
+// replace moves the unpacked pack at root to dest. A previous version at
+// dest is moved aside first and put back if the new one can't be moved in.
+func replace(dir, dest, root string) error {
+	aside, err := os.MkdirTemp(dir, ".previous-")
+	if err != nil {
+		return fmt.Errorf("failed to create backup directory: %w", err)
+	}
+	previous := filepath.Join(aside, filepath.Base(dest))
+
+	if err := os.Rename(dest, previous); err != nil {
+		os.Remove(aside)
+		if !os.IsNotExist(err) {
+			return fmt.Errorf("failed to move previous version aside: %w", err)
+		}
+		if err := os.Rename(root, dest); err != nil {
+			return fmt.Errorf("failed to install pack: %w", err)
+		}
+		return nil
+	}
+
+	if err := os.Rename(root, dest); err != nil {
+		if restoreErr := os.Rename(previous, dest); restoreErr != nil {
+			// Keep the backup so the previous version can be recovered by hand
+			return fmt.Errorf("failed to install pack: %w; the previous version is in %s", err, previous)
+		}
+		os.Remove(aside)
+		return fmt.Errorf("failed to install pack: %w", err)
+	}
+
+	if err := os.RemoveAll(aside); err != nil {
+		return fmt.Errorf("installed the pack, but failed to remove the previous version: %w", err)
+	}
+	return nil
+}
+
+// Remove uninstalls the named pack unless another pack depends on it
+func Remove(dir, name string) error {
+	installed, _ := List(dir)
+
+	found := false
+	for _, p := range installed {
+		if p.Name == name {
+			found = true
+		}
+		for _, dep := range p.Dependencies {
+			if dep.Name == name {
+				return fmt.Errorf("pack %s is required by %s", name, p.Name)
+			}
+		}
+	}
+	if !found {
+		return fmt.Errorf("pack %s is not installed", name)
+	}
+
+	if err := os.RemoveAll(filepath.Join(dir, name)); err != nil {
+		return fmt.Errorf("failed to remove pack: %w", err)
+	}
+	return nil
+}
+
+// findRoot locates the manifest, which archives often nest in a single
+// top-level directory
+func findRoot(dir string) (string, error) {
+	if _, err := os.Stat(filepath.Join(dir, ManifestFile)); err == nil {
+		return dir, nil
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", err
+	}
+	if len(entries) == 1 && entries[0].IsDir() {
+		nested := filepath.Join(dir, entries[0].Name())
+		if _, err := os.Stat(filepath.Join(nested, ManifestFile)); err == nil {
+			return nested, nil
+		}
+	}
+
+	return "", fmt.Errorf("pack has no %s", ManifestFile)
+}
+
+// checkDependencies verifies that every dependency of m is installed
+// at a suitable version and that none of them depends on m in turn
+func checkDependencies(m *Manifest, installed []Pack) error {
+	versions := make(map[string]string)
+	for _, p := range installed {
+		versions[p.Name] = p.Version
+	}
+
+	if cycle := dependencyCycle(m, installed); cycle != nil {
+		return fmt.Errorf("pack %s has a dependency cycle: %s", m.Name, strings.Join(cycle, " -> "))
+	}
+
+	for _, dep := range m.Dependencies {
+		version, ok := versions[dep.Name]
+		if !ok {
+			return fmt.Errorf("pack %s requires pack %s, which is not installed", m.Name, dep.Name)
+		}
+		if dep.MinVersion != "" && !atLeast(version, dep.MinVersion) {
+			return fmt.Errorf("pack %s requires %s %s or later (installed: %s)",
+				m.Name, dep.Name, dep.MinVersion, version)
+		}
+	}
+
+	return nil
+}
+
+// checkDependents verifies that m, when it replaces an installed pack of
+// the same name, is still new enough for the packs that depend on it
+func checkDependents(m *Manifest, installed []Pack) error {
+	for _, p := range installed {
+		if p.Name == m.Name {
+			continue
+		}
+		for _, dep := range p.Dependencies {
+			if dep.Name == m.Name && dep.MinVersion != "" && !atLeast(m.Version, dep.MinVersion) {
+				return fmt.Errorf("pack %s requires %s %s or later (installing: %s)",
+					p.Name, m.Name, dep.MinVersion, m.Version)
+			}
+		}
+	}
+	return nil
+}
+
+// dependencyCycle returns the names along a chain of dependencies that
+// leads from m back to m through the installed packs, or nil if there is none
+func dependencyCycle(m *Manifest, installed []Pack) []string {
+	byName := make(map[string]Manifest)
+	for _, p := range installed {
+		byName[p.Name] = p.Manifest
+	}
+	// m replaces any installed pack of the same name
+	byName[m.Name] = *m
+
+	visited := make(map[string]bool)
+	var visit func(name string, chain []string) []string
+	visit = func(name string, chain []string) []string {
+		chain = append(chain, name)
+		if len(chain) > 1 && name == m.Name {
+			return chain
+		}
+		if visited[name] {
+			return nil
+		}
+		visited[name] = true
+		for _, dep := range byName[name].Dependencies {
+			if cycle := visit(dep.Name, chain); cycle != nil {
+				return cycle
+			}
+		}
+		return nil
+	}
+	return visit(m.Name, nil)
+}
+
+// validateContent checks that every lesson and exercise in the pack loads
+func validateContent(fsys fs.FS) error {
+	var errs []error
+
+	tutorials, _ := fs.Glob(fsys, "tutorials/*.yaml")
+	for _, path := range tutorials {
+		if _, err := lessons.Load(fsys, path); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	defs, _ := fs.Glob(fsys, "exercises/*.yaml")
+	for _, path := range defs {
+		if _, err := exercises.LoadDefinition(fsys, path); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	pools, _ := fs.Glob(fsys, "quizzes/*.yaml")
+	for _, path := range pools {
+		if _, err := quiz.LoadPool(fsys, path); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	learningPaths, _ := fs.Glob(fsys, "paths/*.yaml")
+	for _, path := range learningPaths {
+		if _, err := paths.Load(fsys, path); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// dependencyOrder sorts packs by name, then moves dependencies ahead of
+// the packs that need them
+func dependencyOrder(packs []Pack) []Pack {
+	sort.Slice(packs, func(i, j int) bool {
+		return packs[i].Name < packs[j].Name
+	})
+
+	byName := make(map[string]Pack)
+	for _, p := range packs {
+		byName[p.Name] = p
+	}
+
+	var ordered []Pack
+	visited := make(map[string]bool)
+	var visit func(p Pack)
+	visit = func(p Pack) {
+		if visited[p.Name] {
+			return
+		}
+		visited[p.Name] = true
+		for _, dep := range p.Dependencies {
+			if d, ok := byName[dep.Name]; ok {
+				visit(d)
+			}
+		}
+		ordered = append(ordered, p)
+	}
+
+	for _, p := range packs {
+		visit(p)
+	}
+	return ordered
+}
@@ -0,0 +1,305 @@
+package packs
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSafePath(t *testing.T) {
+	dest := filepath.FromSlash("/packs/.install-1")
+	tests := []struct {
+		name string
+		want string // Empty if the name is rejected
+	}{
+		{"pack.yaml", "pack.yaml"},
+		{"tutorials/basics.yaml", "tutorials/basics.yaml"},
+		{"./pack.yaml", "pack.yaml"},
+		{"tutorials/../pack.yaml", "pack.yaml"},
+		{"..notes", "..notes"},
+		{"..", ""},
+		{"../pack.yaml", ""},
+		{"tutorials/../../pack.yaml", ""},
+		{"/etc/passwd", ""},
+	}
+
+	for _, tt := range tests {
+		got, err := safePath(dest, tt.name)
+		if tt.want == "" {
+			if err == nil {
+				t.Errorf("safePath(%q) = %q, want an error", tt.name, got)
+			}
+			continue
+		}
+		if want := filepath.Join(dest, filepath.FromSlash(tt.want)); err != nil || got != want {
+			t.Errorf("safePath(%q) = %q, %v; want %q", tt.name, got, err, want)
+		}
+	}
+}
+
+// writeZip writes a zip archive holding a file for each name
+func writeZip(t *testing.T, path string, names ...string) {
+	t.Helper()
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	w := zip.NewWriter(f)
+	for _, name := range names {
+		entry, err := w.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		entry.Write([]byte("name: escaped\n"))
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// writeTarGz writes a gzip-compressed tar archive holding a file for each name
+func writeTarGz(t *testing.T, path string, names ...string) {
+	t.Helper()
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	gz := gzip.NewWriter(f)
+	w := tar.NewWriter(gz)
+	content := []byte("name: escaped\n")
+	for _, name := range names {
+		header := &tar.Header{Name: name, Typeflag: tar.TypeReg, Mode: 0644, Size: int64(len(content))}
+		if err := w.WriteHeader(header); err != nil {
+			t.Fatal(err)
+		}
+		w.Write(content)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUnpackRejectsEscapes(t *testing.T) {
+	for _, escape := range []string{"../escaped.yaml", "tutorials/../../escaped.yaml", "/tmp/escaped.yaml"} {
+		for _, format := range []string{"zip", "tar.gz"} {
+			t.Run(format+" "+escape, func(t *testing.T) {
+				dir := t.TempDir()
+				archive := filepath.Join(dir, "pack."+format)
+				if format == "zip" {
+					writeZip(t, archive, "pack.yaml", escape)
+				} else {
+					writeTarGz(t, archive, "pack.yaml", escape)
+				}
+
+				dest := filepath.Join(dir, "dest", "staging")
+				if err := os.MkdirAll(dest, 0755); err != nil {
+					t.Fatal(err)
+				}
+				err := unpack(archive, dest)
+				if err == nil || !strings.Contains(err.Error(), "outside the pack") {
+					t.Errorf("got error %v, want one about an entry outside the pack", err)
+				}
+				if _, err := os.Stat(filepath.Join(dir, "dest", "escaped.yaml")); err == nil {
+					t.Error("an entry was written outside the pack")
+				}
+			})
+		}
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.2.3", "1.2.3", 0},
+		{"v1.2", "1.2.0", 0},
+		{"1.2", "1.10", -1},
+		{"2.0.0", "1.9.9", 1},
+		{"1.2.1", "1.2", 1},
+		{"0.9", "1", -1},
+	}
+	for _, tt := range tests {
+		got, err := CompareVersions(tt.a, tt.b)
+		if err != nil || got != tt.want {
+			t.Errorf("CompareVersions(%q, %q) = %d, %v; want %d", tt.a, tt.b, got, err, tt.want)
+		}
+	}
+
+	for _, bad := range []string{"", "v", "1.x", "1..2", "-1.0"} {
+		if _, err := CompareVersions(bad, "1.0"); err == nil {
+			t.Errorf("CompareVersions(%q, ...) accepted an invalid version", bad)
+		}
+	}
+}
+
+// pack returns an installed pack that depends on each of deps, given as
+// name or name@min_version
+func pack(name, version string, deps ...string) Pack {
+	m := Manifest{Name: name, Version: version}
+	for _, dep := range deps {
+		depName, min, _ := strings.Cut(dep, "@")
+		m.Dependencies = append(m.Dependencies, Dependency{Name: depName, MinVersion: min})
+	}
+	return Pack{Manifest: m}
+}
+
+func TestCheckDependencies(t *testing.T) {
+	installed := []Pack{
+		pack("base", "0.2.0"),
+		pack("style", "1.0.0", "base"),
+		pack("extras", "1.0.0", "company"),
+	}
+	tests := []struct {
+		name    string
+		pack    Pack
+		wantErr string
+	}{
+		{"no dependencies", pack("company", "1.0.0"), ""},
+		{"installed", pack("company", "1.0.0", "base", "style"), ""},
+		{"new enough", pack("company", "1.0.0", "base@0.2"), ""},
+		{"not installed", pack("company", "1.0.0", "lint"), "requires pack lint, which is not installed"},
+		{"too old", pack("company", "1.0.0", "base@0.3.0"), "requires base 0.3.0 or later (installed: 0.2.0)"},
+		{"itself", pack("company", "1.0.0", "company"), "dependency cycle: company -> company"},
+		{"cycle", pack("company", "1.0.0", "base", "extras"), "dependency cycle: company -> extras -> company"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkDependencies(&tt.pack.Manifest, installed)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("got error %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("got error %v, want one containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckDependents(t *testing.T) {
+	installed := []Pack{
+		pack("base", "0.3.0"),
+		pack("company", "1.0.0", "base@0.3.0"),
+		pack("style", "1.0.0", "base"),
+	}
+	upgrade, downgrade := pack("base", "0.4.0"), pack("base", "0.2.0")
+	if err := checkDependents(&upgrade.Manifest, installed); err != nil {
+		t.Errorf("upgrade: got error %v", err)
+	}
+	err := checkDependents(&downgrade.Manifest, installed)
+	if err == nil || !strings.Contains(err.Error(), "company requires base 0.3.0 or later (installing: 0.2.0)") {
+		t.Errorf("downgrade: got error %v, want one naming company", err)
+	}
+}
+
+func TestDependencyOrder(t *testing.T) {
+	names := func(packs []Pack) []string {
+		var list []string
+		for _, p := range packs {
+			list = append(list, p.Name)
+		}
+		return list
+	}
+	tests := []struct {
+		name  string
+		packs []Pack
+		want  []string
+	}{
+		{"by name", []Pack{pack("b", "1"), pack("a", "1")}, []string{"a", "b"}},
+		{"chain", []Pack{pack("a", "1", "b"), pack("b", "1", "c"), pack("c", "1")}, []string{"c", "b", "a"}},
+		{"diamond", []Pack{pack("a", "1", "b", "c"), pack("b", "1", "d"), pack("c", "1", "d"), pack("d", "1")}, []string{"d", "b", "c", "a"}},
+		{"missing dependency", []Pack{pack("a", "1", "gone")}, []string{"a"}},
+		{"cycle", []Pack{pack("a", "1", "b"), pack("b", "1", "a"), pack("c", "1")}, []string{"b", "a", "c"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := names(dependencyOrder(tt.packs)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// writePack writes a pack directory with only a manifest
+func writePack(t *testing.T, manifest string) string {
+	t.Helper()
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, ManifestFile), []byte(manifest), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestInstallReplaces(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := Install(dir, writePack(t, "name: base\nversion: 0.3.0\n"), "1.0.0"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Install(dir, writePack(t, "name: company\nversion: 1.0.0\ndependencies:\n  - name: base\n    min_version: 0.3.0\n"), "1.0.0"); err != nil {
+		t.Fatal(err)
+	}
+
+	// A version older than a dependent needs is refused and the installed one kept
+	_, err := Install(dir, writePack(t, "name: base\nversion: 0.2.0\n"), "1.0.0")
+	if err == nil || !strings.Contains(err.Error(), "company requires base 0.3.0") {
+		t.Errorf("got error %v, want one naming company", err)
+	}
+	if _, err := Install(dir, writePack(t, "name: base\nversion: 0.4.0\n"), "1.0.0"); err != nil {
+		t.Fatal(err)
+	}
+
+	installed, err := List(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, p := range installed {
+		got = append(got, p.Name+" "+p.Version)
+	}
+	if want := []string{"base 0.4.0", "company 1.0.0"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("installed %q, want %q", got, want)
+	}
+
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 2 {
+		t.Errorf("packs directory holds %d entries, want only the 2 packs", len(entries))
+	}
+}
+
+func TestReplaceRestoresPrevious(t *testing.T) {
+	dir := t.TempDir()
+	dest := filepath.Join(dir, "base")
+	if err := os.Mkdir(dest, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dest, ManifestFile), []byte("name: base\nversion: 0.3.0\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// Moving a directory that does not exist into place fails
+	if err := replace(dir, dest, filepath.Join(dir, ".install-missing")); err == nil {
+		t.Fatal("replacing with a missing directory succeeded")
+	}
+	if _, err := os.Stat(filepath.Join(dest, ManifestFile)); err != nil {
+		t.Errorf("the previous version was not restored: %s", err)
+	}
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 1 {
+		t.Errorf("packs directory holds %d entries, want only the restored pack", len(entries))
+	}
+}
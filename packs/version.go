@@ -0,0 +1,61 @@
+package packs
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// parseVersion splits a version like "1.2.3" or "v1.2" into its numbers
+func parseVersion(version string) ([]int, error) {
+	trimmed := strings.TrimPrefix(version, "v")
+	if trimmed == "" {
+		return nil, fmt.Errorf("missing version")
+	}
+
+	var parts []int
+	for _, field := range strings.Split(trimmed, ".") {
+		n, err := strconv.Atoi(field)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid version %q", version)
+		}
+		parts = append(parts, n)
+	}
+	return parts, nil
+}
+
+// CompareVersions returns -1, 0 or 1 depending on whether a is older than,
+// the same as, or newer than b. Missing trailing numbers count as zero.
+func CompareVersions(a, b string) (int, error) {
+	va, err := parseVersion(a)
+	if err != nil {
+		return 0, err
+	}
+	vb, err := parseVersion(b)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := 0; i < len(va) || i < len(vb); i++ {
+		var x, y int
+		if i < len(va) {
+			x = va[i]
+		}
+		if i < len(vb) {
+			y = vb[i]
+		}
+		if x < y {
+			return -1, nil
+		}
+		if x > y {
+			return 1, nil
+		}
+	}
+	return 0, nil
+}
+
+// atLeast reports whether version is the same as or newer than min
+func atLeast(version, min string) bool {
+	cmp, err := CompareVersions(version, min)
+	return err == nil && cmp >= 0
+}
@@ -0,0 +1,76 @@
+package paths
+
+import (
+	"errors"
+	"fmt"
+	"gocli-teacher/registry"
+	"io/fs"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Path describes a learning path loaded from a content file
+type Path struct {
+	ID          string   `yaml:"id"`
+	Title       string   `yaml:"title"`
+	Description string   `yaml:"description"`
+	Order       int      `yaml:"order"` // Position in path listings
+	Items       []string `yaml:"items"` // IDs of tutorials and exercises, in order
+}
+
+// Load reads and parses a learning path from the given file system
+func Load(fsys fs.FS, path string) (*Path, error) {
+	data, err := fs.ReadFile(fsys, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read path: %w", err)
+	}
+
+	var p Path
+	if err := yaml.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("%s: failed to parse path: %w", path, err)
+	}
+
+	if p.ID == "" {
+		return nil, fmt.Errorf("%s: path is missing an id", path)
+	}
+	if p.Title == "" {
+		return nil, fmt.Errorf("%s: path %s is missing a title", path, p.ID)
+	}
+	if len(p.Items) == 0 {
+		return nil, fmt.Errorf("%s: path %s has no items", path, p.ID)
+	}
+
+	return &p, nil
+}
+
+// Register loads every learning path in the paths directory of fsys and
+// adds it to the registry. Paths that fail to load are skipped and reported
+// in the returned error.
+func Register(fsys fs.FS) error {
+	files, err := fs.Glob(fsys, "paths/*.yaml")
+	if err != nil {
+		return fmt.Errorf("failed to list paths: %w", err)
+	}
+
+	var errs []error
+	for _, file := range files {
+		p, err := Load(fsys, file)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		err = registry.RegisterPath(registry.Path{
+			ID:          p.ID,
+			Title:       p.Title,
+			Description: p.Description,
+			Order:       p.Order,
+			Items:       p.Items,
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", file, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,32 @@
+package paths_test
+
+import (
+	"gocli-teacher/content"
+	"gocli-teacher/exercises"
+	"gocli-teacher/paths"
+	"gocli-teacher/registry"
+	"gocli-teacher/tutorials"
+	"testing"
+)
+
+// TestBuiltinContent loads the built-in lessons and paths as the tool does
+// and checks the references between them
+func TestBuiltinContent(t *testing.T) {
+	fsys := content.Builtin()
+	for _, register := range []func() error{
+		func() error { return tutorials.Register(fsys) },
+		func() error { return tutorials.RegisterQuizzes(fsys) },
+		func() error { return exercises.Register(fsys) },
+		func() error { return paths.Register(fsys) },
+	} {
+		if err := register(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(registry.Paths()) == 0 {
+		t.Fatal("no built-in paths were registered")
+	}
+	if err := registry.Validate(); err != nil {
+		t.Error(err)
+	}
+}
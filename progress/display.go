@@ -20,8 +20,109 @@ type ExerciseInfo struct {
 	Difficulty  string
 }
 
+// ItemInfo identifies a tutorial or exercise in a learning sequence
+type ItemInfo struct {
+	Type        string // "Tutorial" or "Exercise"
+	Name        string
+	Description string
+}
+
+// IsItemCompleted checks if a tutorial or exercise has been completed
+func (t *Tracker) IsItemCompleted(item ItemInfo) bool {
+	if item.Type == "Exercise" {
+		return t.IsExerciseCompleted(item.Name)
+	}
+	return t.IsTutorialCompleted(item.Name)
+}
+
+// PathInfo contains info about a learning path
+type PathInfo struct {
+	Name        string
+	Title       string
+	Description string
+	Items       []ItemInfo
+}
+
+// GetPathProgress returns a summary of progress through a learning path
+func (t *Tracker) GetPathProgress(path PathInfo) (int, int, float64) {
+	completed := 0
+	for _, item := range path.Items {
+		if t.IsItemCompleted(item) {
+			completed++
+		}
+	}
+
+	total := len(path.Items)
+	var percentage float64 = 0
+	if total > 0 {
+		percentage = float64(completed) / float64(total) * 100
+	}
+
+	return completed, total, percentage
+}
+
+// NextItem returns the first item that has not been completed
+func (t *Tracker) NextItem(items []ItemInfo) (ItemInfo, bool) {
+	for _, item := range items {
+		if !t.IsItemCompleted(item) {
+			return item, true
+		}
+	}
+	return ItemInfo{}, false
+}
+
+// FormatRecommendedOrder formats tutorials and exercises in the order they
+// should be taken, marking completed ones and the next one to take
+func FormatRecommendedOrder(tracker *Tracker, items []ItemInfo) string {
+	var sb strings.Builder
+	sb.WriteString("\n==========================================\n")
+	sb.WriteString("           Recommended Order\n")
+	sb.WriteString("==========================================\n\n")
+
+	writeItemList(&sb, tracker, items)
+
+	return sb.String()
+}
+
+// FormatPathStatus formats the user's progress through a learning path
+func FormatPathStatus(tracker *Tracker, path PathInfo) string {
+	completed, total, percentage := tracker.GetPathProgress(path)
+	progressBar := createProgressBar(percentage, 40)
+
+	var sb strings.Builder
+	sb.WriteString("\n==========================================\n")
+	sb.WriteString(fmt.Sprintf("  %s (%s)\n", path.Title, path.Name))
+	sb.WriteString("==========================================\n\n")
+	if path.Description != "" {
+		sb.WriteString(path.Description + "\n\n")
+	}
+	sb.WriteString(fmt.Sprintf("Path Progress: %d/%d (%.1f%%)\n", completed, total, percentage))
+	sb.WriteString(fmt.Sprintf("%s\n\n", progressBar))
+
+	writeItemList(&sb, tracker, path.Items)
+
+	return sb.String()
+}
+
+// writeItemList writes a numbered list of items, marking completed ones
+// and the next one to take
+func writeItemList(sb *strings.Builder, tracker *Tracker, items []ItemInfo) {
+	next, hasNext := tracker.NextItem(items)
+	for i, item := range items {
+		status := "[ ]"
+		marker := ""
+		if tracker.IsItemCompleted(item) {
+			status = "[✓]"
+		} else if hasNext && item == next {
+			marker = "  <- next"
+			hasNext = false
+		}
+		sb.WriteString(fmt.Sprintf("%2d. %s [%s] %s: %s%s\n", i+1, status, item.Type, item.Name, item.Description, marker))
+	}
+}
+
 // FormatAllProgress formats the user's overall progress
-func FormatAllProgress(tracker *Tracker, tutorials []TutorialInfo, exercises []ExerciseInfo) string {
+func FormatAllProgress(tracker *Tracker, tutorials []TutorialInfo, exercises []ExerciseInfo, paths []PathInfo) string {
 	// Calculate overall progress
 	completed, total, percentage := tracker.GetProgress(len(tutorials), len(exercises))
 	
@@ -64,6 +165,23 @@ func FormatAllProgress(tracker *Tracker, tutorials []TutorialInfo, exercises []E
 		sb.WriteString(fmt.Sprintf("%s %s (%s): %s\n", status, ex.Name, ex.Difficulty, ex.Description))
 	}
 	
+	// Add learning path progress
+	if len(paths) > 0 {
+		sb.WriteString("\n")
+		sb.WriteString(fmt.Sprintf("Learning Paths: %d\n", len(paths)))
+		sb.WriteString("------------------------------------------\n")
+		
+		for _, path := range paths {
+			pathCompleted, pathTotal, pathPercentage := tracker.GetPathProgress(path)
+			active := ""
+			if path.Name == tracker.ActivePath() {
+				active = " (active)"
+			}
+			sb.WriteString(fmt.Sprintf("%s %d/%d (%.1f%%) %s%s\n",
+				createProgressBar(pathPercentage, 10), pathCompleted, pathTotal, pathPercentage, path.Name, active))
+		}
+	}
+	
 	return sb.String()
 }
 
@@ -0,0 +1,78 @@
+package progress
+
+import "time"
+
+// TestResult records how one test case or code check went in an attempt
+type TestResult struct {
+	Name    string `json:"name"`
+	Check   bool   `json:"check,omitempty"` // A rule about the code rather than a test case
+	Passed  bool   `json:"passed"`
+	Outcome string `json:"outcome,omitempty"` // How a test case ended, such as "Timed out"
+}
+
+// ExerciseAttempt records one grading of the learner's program for an exercise
+type ExerciseAttempt struct {
+	Exercise  string        `json:"exercise"` // ID of the exercise
+	GradedAt  time.Time     `json:"graded_at"`
+	Score     int           `json:"score"`
+	Completed bool          `json:"completed"` // Every test case passed and every check was met
+	Results   []TestResult  `json:"results,omitempty"`
+	Duration  time.Duration `json:"duration,omitempty"` // Time spent on the program since the exercise started or was last graded, if known
+	HintsUsed int           `json:"hints_used"`         // Hints shown with the results
+	Source    string        `json:"source,omitempty"`   // Hash of the program graded
+}
+
+// RecordExerciseAttempt stores an attempt at an exercise
+func (t *Tracker) RecordExerciseAttempt(attempt ExerciseAttempt) error {
+	if attempt.GradedAt.IsZero() {
+		attempt.GradedAt = time.Now()
+	}
+	t.data.ExerciseAttempts = append(t.data.ExerciseAttempts, attempt)
+	return t.save()
+}
+
+// GetExerciseAttempts returns the recorded attempts at an exercise, oldest
+// first. An empty exercise returns the attempts at every exercise.
+func (t *Tracker) GetExerciseAttempts(exercise string) []ExerciseAttempt {
+	var attempts []ExerciseAttempt
+	for _, attempt := range t.data.ExerciseAttempts {
+		if exercise == "" || attempt.Exercise == exercise {
+			attempts = append(attempts, attempt)
+		}
+	}
+	return attempts
+}
+
+// GetLatestExerciseAttempt returns the most recent attempt at an exercise
+func (t *Tracker) GetLatestExerciseAttempt(exercise string) (ExerciseAttempt, bool) {
+	attempts := t.GetExerciseAttempts(exercise)
+	if len(attempts) == 0 {
+		return ExerciseAttempt{}, false
+	}
+	return attempts[len(attempts)-1], true
+}
+
+// GetBestExerciseAttempt returns the attempt at an exercise with the highest
+// score, the earliest of them if several have it
+func (t *Tracker) GetBestExerciseAttempt(exercise string) (ExerciseAttempt, bool) {
+	var best ExerciseAttempt
+	found := false
+	for _, attempt := range t.GetExerciseAttempts(exercise) {
+		if !found || attempt.Score > best.Score {
+			best = attempt
+			found = true
+		}
+	}
+	return best, found
+}
+
+// GetFirstPassingAttempt returns the first attempt that completed an
+// exercise and how many attempts it took to get there
+func (t *Tracker) GetFirstPassingAttempt(exercise string) (ExerciseAttempt, int, bool) {
+	for i, attempt := range t.GetExerciseAttempts(exercise) {
+		if attempt.Completed {
+			return attempt, i + 1, true
+		}
+	}
+	return ExerciseAttempt{}, 0, false
+}
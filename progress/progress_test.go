@@ -0,0 +1,182 @@
+package progress
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// newTracker returns a tracker that keeps its data in a temporary directory
+func newTracker(t *testing.T) *Tracker {
+	t.Helper()
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	t.Setenv("HOME", dir)
+	tracker, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tracker
+}
+
+// reload reads the data a tracker saved into a new tracker
+func reload(t *testing.T) *Tracker {
+	t.Helper()
+	tracker, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tracker
+}
+
+// answer returns an answer to a question that took seconds to give
+func answer(id string, correct bool, seconds int) QuizAnswer {
+	return QuizAnswer{QuestionID: id, Answer: []string{"a"}, Correct: correct, Duration: time.Duration(seconds) * time.Second}
+}
+
+func TestQuizAttempts(t *testing.T) {
+	tracker := newTracker(t)
+	day := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	attempts := []QuizAttempt{
+		{Tutorial: "flags", Version: "1.0.0", Mode: "tutorial", CompletedAt: day,
+			Answers: []QuizAnswer{answer("flags-1", true, 4), answer("flags-2", false, 10)}},
+		{Tutorial: "basics", Mode: "quiz", CompletedAt: day.Add(time.Hour),
+			Answers: []QuizAnswer{answer("basics-1", false, 6)}},
+		{Tutorial: "flags", Version: "1.1.0", Mode: "review", CompletedAt: day.Add(2 * time.Hour),
+			Answers: []QuizAnswer{answer("flags-2", true, 20), answer("flags-1", true, 2)}},
+	}
+	for _, a := range attempts {
+		if err := tracker.RecordQuizAttempt(a); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tracker.RecordQuizAttempt(QuizAttempt{Tutorial: "commands", Mode: "quiz"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Attempts are kept on disk
+	tracker = reload(t)
+	if got := len(tracker.GetQuizAttempts("")); got != 4 {
+		t.Fatalf("%d attempts recorded, want 4", got)
+	}
+	commands, _ := tracker.GetLatestQuizAttempt("commands")
+	if commands.CompletedAt.IsZero() {
+		t.Error("an attempt without a time was not given one")
+	}
+
+	flags := tracker.GetQuizAttempts("flags")
+	if len(flags) != 2 || flags[0].Version != "1.0.0" || flags[1].Version != "1.1.0" {
+		t.Fatalf("flags attempts %+v, want both in the order taken", flags)
+	}
+	if got := []int{flags[0].Correct(), flags[1].Correct()}; !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Errorf("scores %v, want [1 2]", got)
+	}
+	latest, ok := tracker.GetLatestQuizAttempt("flags")
+	if !ok || latest.Mode != "review" || latest.Correct() != 2 {
+		t.Errorf("latest attempt %+v, want the review with 2 correct", latest)
+	}
+	if _, ok := tracker.GetLatestQuizAttempt("interactive"); ok {
+		t.Error("found an attempt at a quiz that was never taken")
+	}
+
+	if got := tracker.GetQuestionAnswers("flags-2"); len(got) != 2 || got[0].Correct || !got[1].Correct {
+		t.Errorf("answers to flags-2 %+v, want a wrong one then a right one", got)
+	}
+
+	stats := tracker.GetQuestionStats()
+	var order []string
+	for _, s := range stats {
+		order = append(order, s.QuestionID)
+	}
+	if want := []string{"basics-1", "flags-2", "flags-1"}; !reflect.DeepEqual(order, want) {
+		t.Errorf("questions %v, want the least accurate first %v", order, want)
+	}
+	flags2 := stats[1]
+	if flags2.Answered != 2 || flags2.Correct != 1 || flags2.Accuracy() != 50 || flags2.AverageTime != 15*time.Second {
+		t.Errorf("flags-2 stats %+v, want 1 of 2 correct taking 15s on average", flags2)
+	}
+	if !flags2.LastAnswered.Equal(day.Add(2*time.Hour)) || flags2.Tutorial != "flags" {
+		t.Errorf("flags-2 last answered %s in %q, want %s in flags", flags2.LastAnswered, flags2.Tutorial, day.Add(2*time.Hour))
+	}
+
+	if got := tracker.GetMissedQuestions(); !reflect.DeepEqual(got, []string{"basics-1"}) {
+		t.Errorf("missed questions %v, want only basics-1", got)
+	}
+
+	if err := tracker.Reset(); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(reload(t).GetQuizAttempts("")); got != 0 {
+		t.Errorf("%d attempts left after a reset", got)
+	}
+}
+
+func TestMarkExerciseComplete(t *testing.T) {
+	tracker := newTracker(t)
+	if tracker.IsExerciseCompleted("simple_cli") {
+		t.Fatal("a new tracker has a completed exercise")
+	}
+
+	if err := tracker.MarkExerciseComplete("simple_cli", 80); err != nil {
+		t.Fatal(err)
+	}
+	first := tracker.data.Exercises["simple_cli"]
+
+	// A lower score later keeps the first date and the better score
+	time.Sleep(10 * time.Millisecond)
+	if err := tracker.MarkExerciseComplete("simple_cli", 60); err != nil {
+		t.Fatal(err)
+	}
+	status := reload(t).data.Exercises["simple_cli"]
+	if !status.Completed || !status.CompletedAt.Equal(first.CompletedAt) || status.Score != 80 {
+		t.Errorf("after a lower score: %+v, want completed at %s with 80", status, first.CompletedAt)
+	}
+
+	// A higher score replaces the score but not the date
+	if err := tracker.MarkExerciseComplete("simple_cli", 100); err != nil {
+		t.Fatal(err)
+	}
+	status = reload(t).data.Exercises["simple_cli"]
+	if !status.CompletedAt.Equal(first.CompletedAt) || status.Score != 100 {
+		t.Errorf("after a higher score: %+v, want completed at %s with 100", status, first.CompletedAt)
+	}
+}
+
+func TestExerciseAttempts(t *testing.T) {
+	tracker := newTracker(t)
+	day := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	for i, a := range []ExerciseAttempt{
+		{Exercise: "simple_cli", Score: 40},
+		{Exercise: "flag_exercise", Score: 100, Completed: true},
+		{Exercise: "simple_cli", Score: 90},
+		{Exercise: "simple_cli", Score: 100, Completed: true},
+		{Exercise: "simple_cli", Score: 100, Completed: true},
+		{Exercise: "simple_cli", Score: 70},
+	} {
+		a.GradedAt = day.Add(time.Duration(i) * time.Hour)
+		if err := tracker.RecordExerciseAttempt(a); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tracker = reload(t)
+
+	if got := len(tracker.GetExerciseAttempts("simple_cli")); got != 5 {
+		t.Errorf("%d attempts at simple_cli, want 5", got)
+	}
+	if latest, ok := tracker.GetLatestExerciseAttempt("simple_cli"); !ok || latest.Score != 70 {
+		t.Errorf("latest attempt %+v, want the one scoring 70", latest)
+	}
+	if best, ok := tracker.GetBestExerciseAttempt("simple_cli"); !ok || best.Score != 100 || !best.GradedAt.Equal(day.Add(3*time.Hour)) {
+		t.Errorf("best attempt %+v, want the first scoring 100", best)
+	}
+	if first, count, ok := tracker.GetFirstPassingAttempt("simple_cli"); !ok || count != 3 || !first.GradedAt.Equal(day.Add(3*time.Hour)) {
+		t.Errorf("first passing attempt %+v after %d, want the third", first, count)
+	}
+
+	if _, ok := tracker.GetBestExerciseAttempt("command_exercise"); ok {
+		t.Error("found a best attempt at an exercise never graded")
+	}
+	if _, _, ok := tracker.GetFirstPassingAttempt("command_exercise"); ok {
+		t.Error("found a passing attempt at an exercise never graded")
+	}
+}
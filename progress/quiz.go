@@ -0,0 +1,155 @@
+package progress
+
+import (
+	"sort"
+	"time"
+)
+
+// QuizAnswer records the answer to one quiz question
+type QuizAnswer struct {
+	QuestionID string        `json:"question_id"`
+	Answer     []string      `json:"answer"` // Option IDs chosen, or the text typed, or the line picked
+	Correct    bool          `json:"correct"`
+	Duration   time.Duration `json:"duration"` // Time taken to answer
+}
+
+// QuizAttempt records one run through a quiz
+type QuizAttempt struct {
+	Tutorial    string       `json:"tutorial"`          // ID of the tutorial the questions come from
+	Version     string       `json:"version,omitempty"` // Version of the tutorial when it was taken
+	Mode        string       `json:"mode"`              // How the quiz was taken, such as "tutorial" or "review"
+	CompletedAt time.Time    `json:"completed_at"`
+	Answers     []QuizAnswer `json:"answers"`
+}
+
+// Correct returns the number of questions answered correctly
+func (a QuizAttempt) Correct() int {
+	correct := 0
+	for _, answer := range a.Answers {
+		if answer.Correct {
+			correct++
+		}
+	}
+	return correct
+}
+
+// QuestionStats summarizes every recorded answer to a question
+type QuestionStats struct {
+	QuestionID   string
+	Tutorial     string
+	Answered     int
+	Correct      int
+	AverageTime  time.Duration
+	LastAnswered time.Time
+}
+
+// Accuracy returns the percentage of answers that were correct
+func (s QuestionStats) Accuracy() float64 {
+	if s.Answered == 0 {
+		return 0
+	}
+	return float64(s.Correct) / float64(s.Answered) * 100
+}
+
+// RecordQuizAttempt stores the answers of a quiz attempt
+func (t *Tracker) RecordQuizAttempt(attempt QuizAttempt) error {
+	if attempt.CompletedAt.IsZero() {
+		attempt.CompletedAt = time.Now()
+	}
+	t.data.QuizAttempts = append(t.data.QuizAttempts, attempt)
+	return t.save()
+}
+
+// GetQuizAttempts returns the recorded attempts at a tutorial's quiz, oldest
+// first. An empty tutorial returns the attempts at every quiz.
+func (t *Tracker) GetQuizAttempts(tutorial string) []QuizAttempt {
+	var attempts []QuizAttempt
+	for _, attempt := range t.data.QuizAttempts {
+		if tutorial == "" || attempt.Tutorial == tutorial {
+			attempts = append(attempts, attempt)
+		}
+	}
+	return attempts
+}
+
+// GetLatestQuizAttempt returns the most recent attempt at a tutorial's quiz
+func (t *Tracker) GetLatestQuizAttempt(tutorial string) (QuizAttempt, bool) {
+	attempts := t.GetQuizAttempts(tutorial)
+	if len(attempts) == 0 {
+		return QuizAttempt{}, false
+	}
+	return attempts[len(attempts)-1], true
+}
+
+// GetQuestionAnswers returns every recorded answer to a question, oldest first
+func (t *Tracker) GetQuestionAnswers(questionID string) []QuizAnswer {
+	var answers []QuizAnswer
+	for _, attempt := range t.data.QuizAttempts {
+		for _, answer := range attempt.Answers {
+			if answer.QuestionID == questionID {
+				answers = append(answers, answer)
+			}
+		}
+	}
+	return answers
+}
+
+// GetQuestionStats summarizes the answers to every question that has been
+// answered, least accurate first
+func (t *Tracker) GetQuestionStats() []QuestionStats {
+	byID := make(map[string]*QuestionStats)
+	total := make(map[string]time.Duration)
+
+	for _, attempt := range t.data.QuizAttempts {
+		for _, answer := range attempt.Answers {
+			stats, exists := byID[answer.QuestionID]
+			if !exists {
+				stats = &QuestionStats{QuestionID: answer.QuestionID, Tutorial: attempt.Tutorial}
+				byID[answer.QuestionID] = stats
+			}
+			stats.Answered++
+			if answer.Correct {
+				stats.Correct++
+			}
+			total[answer.QuestionID] += answer.Duration
+			if attempt.CompletedAt.After(stats.LastAnswered) {
+				stats.LastAnswered = attempt.CompletedAt
+			}
+		}
+	}
+
+	var result []QuestionStats
+	for id, stats := range byID {
+		stats.AverageTime = total[id] / time.Duration(stats.Answered)
+		result = append(result, *stats)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Accuracy() != result[j].Accuracy() {
+			return result[i].Accuracy() < result[j].Accuracy()
+		}
+		return result[i].QuestionID < result[j].QuestionID
+	})
+	return result
+}
+
+// GetMissedQuestions returns the IDs of questions whose latest answer was wrong
+func (t *Tracker) GetMissedQuestions() []string {
+	latest := make(map[string]bool)
+	var order []string
+	for _, attempt := range t.data.QuizAttempts {
+		for _, answer := range attempt.Answers {
+			if _, seen := latest[answer.QuestionID]; !seen {
+				order = append(order, answer.QuestionID)
+			}
+			latest[answer.QuestionID] = answer.Correct
+		}
+	}
+
+	var missed []string
+	for _, id := range order {
+		if !latest[id] {
+			missed = append(missed, id)
+		}
+	}
+	return missed
+}
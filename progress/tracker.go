@@ -17,8 +17,12 @@ type CompletionStatus struct {
 
 // ProgressData stores all user progress
 type ProgressData struct {
-	Tutorials map[string]CompletionStatus `json:"tutorials"` // Maps tutorial name to status
-	Exercises map[string]CompletionStatus `json:"exercises"` // Maps exercise name to status
+	Tutorials        map[string]CompletionStatus `json:"tutorials"`                   // Maps tutorial name to status
+	Exercises        map[string]CompletionStatus `json:"exercises"`                   // Maps exercise name to status
+	ActivePath       string                      `json:"active_path,omitempty"`       // Learning path being followed
+	QuizAttempts     []QuizAttempt               `json:"quiz_attempts,omitempty"`     // Every quiz taken, oldest first
+	Workspaces       map[string]string           `json:"workspaces,omitempty"`        // Maps exercise name to the directory of the learner's program
+	ExerciseAttempts []ExerciseAttempt           `json:"exercise_attempts,omitempty"` // Every grading of an exercise, oldest first
 }
 
 // Tracker manages progress tracking
@@ -66,13 +70,19 @@ func (t *Tracker) MarkTutorialComplete(name string) error {
 	return t.save()
 }
 
-// MarkExerciseComplete marks an exercise as completed with an optional score
+// MarkExerciseComplete marks an exercise as completed with an optional score.
+// Completing it again keeps the date it was first completed and the best
+// score.
 func (t *Tracker) MarkExerciseComplete(name string, score int) error {
-	t.data.Exercises[name] = CompletionStatus{
-		Completed:  true,
-		CompletedAt: time.Now(),
-		Score:      score,
+	status, exists := t.data.Exercises[name]
+	if !exists || !status.Completed {
+		status = CompletionStatus{
+			Completed:  true,
+			CompletedAt: time.Now(),
+		}
 	}
+	status.Score = max(status.Score, score)
+	t.data.Exercises[name] = status
 	return t.save()
 }
 
@@ -88,6 +98,32 @@ func (t *Tracker) IsExerciseCompleted(name string) bool {
 	return exists && status.Completed
 }
 
+// SetActivePath records the learning path the user is following
+func (t *Tracker) SetActivePath(name string) error {
+	t.data.ActivePath = name
+	return t.save()
+}
+
+// ActivePath returns the learning path the user is following, if any
+func (t *Tracker) ActivePath() string {
+	return t.data.ActivePath
+}
+
+// SetWorkspace records the directory the learner's program for an exercise is in
+func (t *Tracker) SetWorkspace(name, dir string) error {
+	if t.data.Workspaces == nil {
+		t.data.Workspaces = make(map[string]string)
+	}
+	t.data.Workspaces[name] = dir
+	return t.save()
+}
+
+// Workspace returns the directory recorded for an exercise, if any
+func (t *Tracker) Workspace(name string) (string, bool) {
+	dir, ok := t.data.Workspaces[name]
+	return dir, ok
+}
+
 // GetCompletedTutorials returns a list of completed tutorials
 func (t *Tracker) GetCompletedTutorials() []string {
 	var completed []string
@@ -172,6 +208,11 @@ func (t *Tracker) load() error {
 	return nil
 }
 
+// ConfigDir returns the directory where gocli-teacher stores its data
+func ConfigDir() (string, error) {
+	return getUserConfigDir()
+}
+
 // getUserConfigDir returns the directory for storing config
 func getUserConfigDir() (string, error) {
 	// Get user config directory
@@ -0,0 +1,81 @@
+package quiz
+
+import (
+	"math/rand"
+	"strconv"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Option is one choice of a question
+type Option struct {
+	ID      string `yaml:"id"` // Stable key used to check and record answers
+	Text    string `yaml:"text"`
+	Correct bool   `yaml:"correct"` // Marks a correct choice
+}
+
+// UnmarshalYAML accepts a plain string as an option with only its text set
+func (o *Option) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		o.Text = value.Value
+		return nil
+	}
+
+	type plain Option
+	return value.Decode((*plain)(o))
+}
+
+// random orders the options shown to the learner
+var random = rand.New(rand.NewSource(time.Now().UnixNano()))
+
+// Seed makes the order of shown options repeatable
+func Seed(seed int64) {
+	random = rand.New(rand.NewSource(seed))
+}
+
+// shuffle returns the options in a random order
+func shuffle(options []Option) []Option {
+	shuffled := append([]Option(nil), options...)
+	random.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+	return shuffled
+}
+
+// optionID returns the default ID of the option at index i: a, b, c, ...
+func optionID(i int) string {
+	if i < 26 {
+		return string(rune('a' + i))
+	}
+	return strconv.Itoa(i + 1)
+}
+
+// texts returns the text of each option
+func texts(options []Option) []string {
+	var list []string
+	for _, o := range options {
+		list = append(list, o.Text)
+	}
+	return list
+}
+
+// ids returns the ID of each option at the given indexes
+func ids(options []Option, indexes []int) []string {
+	var list []string
+	for _, i := range indexes {
+		list = append(list, options[i].ID)
+	}
+	return list
+}
+
+// correctIndexes returns the indexes of the correct options
+func correctIndexes(options []Option) []int {
+	var indexes []int
+	for i, o := range options {
+		if o.Correct {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes
+}
@@ -0,0 +1,50 @@
+package quiz
+
+import (
+	"fmt"
+	"io/fs"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Pool is a set of extra questions about a topic, drawn from at random
+type Pool struct {
+	Topic     string     `yaml:"topic"` // ID of the tutorial the questions are about
+	Questions []Question `yaml:"questions"`
+}
+
+// LoadPool reads, parses and prepares a question pool from the given file system
+func LoadPool(fsys fs.FS, path string) (*Pool, error) {
+	data, err := fs.ReadFile(fsys, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read question pool: %w", err)
+	}
+
+	var pool Pool
+	if err := yaml.Unmarshal(data, &pool); err != nil {
+		return nil, fmt.Errorf("%s: failed to parse question pool: %w", path, err)
+	}
+
+	if pool.Topic == "" {
+		return nil, fmt.Errorf("%s: question pool is missing a topic", path)
+	}
+	q := Quiz{Questions: pool.Questions}
+	if err := q.Prepare(pool.Topic + ".pool"); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	pool.Questions = q.Questions
+
+	return &pool, nil
+}
+
+// Pick returns up to n questions chosen at random
+func Pick(questions []Question, n int) []Question {
+	picked := append([]Question(nil), questions...)
+	random.Shuffle(len(picked), func(i, j int) {
+		picked[i], picked[j] = picked[j], picked[i]
+	})
+	if n < len(picked) {
+		picked = picked[:n]
+	}
+	return picked
+}
@@ -0,0 +1,208 @@
+// Package quiz asks and scores quiz questions of several types.
+package quiz
+
+import (
+	"errors"
+	"fmt"
+	"gocli-teacher/utils"
+	"time"
+)
+
+// Quiz is a set of questions asked together
+type Quiz struct {
+	Intro     string     `yaml:"intro"`
+	Questions []Question `yaml:"questions"`
+}
+
+// Question is a quiz question. Which fields are used depends on its type.
+type Question struct {
+	ID          string   `yaml:"id"`   // Stable key used to record answers
+	Type        string   `yaml:"type"` // One of the registered types, defaults to single
+	Prompt      string   `yaml:"prompt"`
+	Code        string   `yaml:"code"`    // Shown with line numbers below the prompt
+	Options     []Option `yaml:"options"` // Choices, or the items in their correct order for ordering questions
+	Answer      string   `yaml:"answer"`  // Text of the correct option, for content written before options had IDs
+	Answers     []string `yaml:"answers"` // Accepted free-text answers, or the texts of correct options as for Answer
+	Pattern     string   `yaml:"pattern"` // Regular expression accepted for a free-text answer
+	Line        int      `yaml:"line"`    // Line of Code that has the bug
+	Explanation string   `yaml:"explanation"`
+	Correct     string   `yaml:"correct"`   // Feedback for a correct answer, defaults to "Correct!"
+	Incorrect   string   `yaml:"incorrect"` // Feedback for a wrong answer, defaults to "Not quite."
+}
+
+// Result is the outcome of one question
+type Result struct {
+	QuestionID string
+	Type       string
+	Prompt     string
+	Answer     []string // Option IDs chosen, or the text typed, or the line picked
+	Correct    bool
+	Duration   time.Duration // Time taken to answer
+}
+
+// Results is the outcome of a quiz
+type Results struct {
+	Questions []Result
+	Correct   int
+	Total     int
+}
+
+// Score returns the percentage of questions answered correctly
+func (r Results) Score() int {
+	if r.Total == 0 {
+		return 0
+	}
+	return r.Correct * 100 / r.Total
+}
+
+// Prepare gives questions without an ID one based on prefix and their
+// position, fills in their option IDs and checks that every question can
+// be asked and scored
+func (q *Quiz) Prepare(prefix string) error {
+	var errs []error
+	seen := make(map[string]bool)
+	for i := range q.Questions {
+		question := &q.Questions[i]
+		if question.ID == "" {
+			question.ID = fmt.Sprintf("%s.%d", prefix, i+1)
+		}
+		if seen[question.ID] {
+			errs = append(errs, fmt.Errorf("question %d: id %s is used twice", i+1, question.ID))
+		}
+		seen[question.ID] = true
+
+		if err := question.Prepare(); err != nil {
+			errs = append(errs, fmt.Errorf("question %d: %w", i+1, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Prepare fills in missing option IDs, marks the options named by Answer
+// and Answers as correct and checks that the question can be asked and scored
+func (q *Question) Prepare() error {
+	if q.Prompt == "" {
+		return fmt.Errorf("missing a prompt")
+	}
+
+	seen := make(map[string]bool)
+	for i := range q.Options {
+		option := &q.Options[i]
+		if option.ID == "" {
+			option.ID = optionID(i)
+		}
+		if seen[option.ID] {
+			return fmt.Errorf("option id %s is used twice", option.ID)
+		}
+		seen[option.ID] = true
+	}
+
+	t, err := q.questionType()
+	if err != nil {
+		return err
+	}
+
+	if q.typeName() == Single || q.typeName() == Multi {
+		for _, text := range append([]string{q.Answer}, q.Answers...) {
+			if text != "" && !q.markCorrect(text) {
+				return fmt.Errorf("answer %q is not one of its options", text)
+			}
+		}
+	}
+
+	return t.Validate(q)
+}
+
+// markCorrect marks the option with the given text as correct
+func (q *Question) markCorrect(text string) bool {
+	for i := range q.Options {
+		if q.Options[i].Text == text {
+			q.Options[i].Correct = true
+			return true
+		}
+	}
+	return false
+}
+
+// Option returns the option with the given ID
+func (q *Question) Option(id string) (Option, bool) {
+	for _, o := range q.Options {
+		if o.ID == id {
+			return o, true
+		}
+	}
+	return Option{}, false
+}
+
+// typeName returns the name of the question's type
+func (q *Question) typeName() string {
+	if q.Type == "" {
+		return Single
+	}
+	return q.Type
+}
+
+// questionType returns the registered type of the question
+func (q *Question) questionType() (Type, error) {
+	name := q.typeName()
+	t, ok := types[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown question type %q", name)
+	}
+	return t, nil
+}
+
+// Run asks every question of the quiz, showing feedback after each answer
+func Run(q Quiz) Results {
+	fmt.Println(q.Intro)
+
+	var results Results
+	for i, question := range q.Questions {
+		result := Ask(question, i+1)
+		results.Questions = append(results.Questions, result)
+		results.Total++
+		if result.Correct {
+			results.Correct++
+		}
+
+		utils.PressEnterToContinue()
+	}
+
+	return results
+}
+
+// Ask asks a single question, numbered for display, and shows feedback
+func Ask(q Question, number int) Result {
+	result := Result{QuestionID: q.ID, Type: q.typeName(), Prompt: q.Prompt}
+
+	t, err := q.questionType()
+	if err != nil {
+		fmt.Println(err)
+		return result
+	}
+
+	prompt := fmt.Sprintf("\n%d. %s", number, q.Prompt)
+	start := time.Now()
+	result.Answer = t.Ask(prompt, &q)
+	result.Duration = time.Since(start)
+	result.Correct = t.Check(&q, result.Answer)
+
+	if result.Correct {
+		fmt.Println(orDefault(q.Correct, "Correct!"))
+	} else {
+		fmt.Println(orDefault(q.Incorrect, "Not quite."))
+	}
+	if q.Explanation != "" {
+		fmt.Println(q.Explanation)
+	}
+
+	return result
+}
+
+// orDefault returns s, or fallback if s is empty
+func orDefault(s, fallback string) string {
+	if s == "" {
+		return fallback
+	}
+	return s
+}
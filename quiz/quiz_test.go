@@ -0,0 +1,232 @@
+package quiz
+
+import (
+	"gocli-teacher/utils"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// prepared returns the question after Prepare, failing the test if it is
+// not valid
+func prepared(t *testing.T, q Question) *Question {
+	t.Helper()
+	if err := q.Prepare(); err != nil {
+		t.Fatal(err)
+	}
+	return &q
+}
+
+// options returns options with the given texts and no IDs
+func options(texts ...string) []Option {
+	var list []Option
+	for _, text := range texts {
+		list = append(list, Option{Text: text})
+	}
+	return list
+}
+
+func TestCheck(t *testing.T) {
+	single := Question{Prompt: "p", Options: options("run", "build", "vet"), Answer: "build"}
+	multi := Question{Type: Multi, Prompt: "p", Options: options("a", "b", "c"), Answers: []string{"a", "c"}}
+	text := Question{Type: Text, Prompt: "p", Answers: []string{"Cobra"}, Pattern: `^go\s+run\b`}
+	order := Question{Type: Ordering, Prompt: "p", Options: options("parse", "validate", "run")}
+	bug := Question{Type: BugLine, Prompt: "p", Code: "a\nb\nc\n", Line: 2}
+
+	tests := []struct {
+		name     string
+		question Question
+		answer   []string
+		want     bool
+	}{
+		{"single correct", single, []string{"b"}, true},
+		{"single wrong", single, []string{"a"}, false},
+		{"single unknown option", single, []string{"z"}, false},
+		{"single none", single, nil, false},
+		{"single two", single, []string{"a", "b"}, false},
+
+		{"multi correct", multi, []string{"a", "c"}, true},
+		{"multi in another order", multi, []string{"c", "a"}, true},
+		{"multi missing one", multi, []string{"a"}, false},
+		{"multi with a wrong one", multi, []string{"a", "b", "c"}, false},
+		{"multi wrong swapped in", multi, []string{"a", "b"}, false},
+		{"multi repeated", multi, []string{"a", "a"}, false},
+
+		{"text exact", text, []string{"Cobra"}, true},
+		{"text case and space", text, []string{"  cobra "}, true},
+		{"text pattern", text, []string{"go  run ."}, true},
+		{"text wrong", text, []string{"viper"}, false},
+		{"text none", text, nil, false},
+
+		{"ordering correct", order, []string{"a", "b", "c"}, true},
+		{"ordering swapped", order, []string{"b", "a", "c"}, false},
+		{"ordering short", order, []string{"a", "b"}, false},
+
+		{"bug line correct", bug, []string{"2"}, true},
+		{"bug line wrong", bug, []string{"3"}, false},
+		{"bug line none", bug, nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := prepared(t, tt.question)
+			qt, err := q.questionType()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := qt.Check(q, tt.answer); got != tt.want {
+				t.Errorf("Check(%q) = %v, want %v", tt.answer, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrepare(t *testing.T) {
+	tests := []struct {
+		name     string
+		question Question
+		wantErr  string
+	}{
+		{"no prompt", Question{Options: options("a"), Answer: "a"}, "missing a prompt"},
+		{"unknown type", Question{Type: "essay", Prompt: "p"}, "unknown question type"},
+		{"answer not an option", Question{Prompt: "p", Options: options("a", "b"), Answer: "c"}, "not one of its options"},
+		{"two correct singles", Question{Prompt: "p", Options: options("a", "b"), Answers: []string{"a", "b"}}, "exactly one correct"},
+		{"duplicate option IDs", Question{Prompt: "p", Options: []Option{{ID: "x", Text: "a", Correct: true}, {ID: "x", Text: "b"}}}, "used twice"},
+		{"text without answers", Question{Type: Text, Prompt: "p"}, "no accepted answers"},
+		{"bad pattern", Question{Type: Text, Prompt: "p", Pattern: "("}, "invalid pattern"},
+		{"one item to order", Question{Type: Ordering, Prompt: "p", Options: options("a")}, "at least two"},
+		{"bug line outside code", Question{Type: BugLine, Prompt: "p", Code: "a\nb", Line: 3}, "outside its 2 lines"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.question.Prepare()
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("got error %v, want one containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPrepareOptionIDs(t *testing.T) {
+	q := prepared(t, Question{Prompt: "p", Options: []Option{{Text: "a"}, {ID: "keep", Text: "b"}, {Text: "c"}}, Answer: "c"})
+	var got []string
+	for _, o := range q.Options {
+		got = append(got, o.ID)
+	}
+	if want := []string{"a", "keep", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("option IDs are %q, want %q", got, want)
+	}
+	if o, _ := q.Option("c"); !o.Correct {
+		t.Error("the option named by Answer is not marked correct")
+	}
+}
+
+// quiet runs f with stdout discarded, as asking prints the question
+func quiet(t *testing.T, f func()) {
+	t.Helper()
+	stdout := os.Stdout
+	devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.Stdout = devNull
+	defer func() {
+		os.Stdout = stdout
+		devNull.Close()
+	}()
+	f()
+}
+
+// TestCheckAfterShuffle answers questions as test mode does, choosing the
+// correct options wherever shuffling put them, and checks that the answers
+// are scored by ID rather than by position
+func TestCheckAfterShuffle(t *testing.T) {
+	utils.TestMode = true
+	defer func() { utils.TestMode = false }()
+
+	questions := []Question{
+		{Prompt: "p", Options: options("one", "two", "three", "four", "five"), Answer: "four"},
+		{Type: Multi, Prompt: "p", Options: options("one", "two", "three", "four", "five"), Answers: []string{"two", "five"}},
+		{Type: Ordering, Prompt: "p", Options: options("one", "two", "three", "four", "five")},
+	}
+	for seed := int64(1); seed <= 20; seed++ {
+		Seed(seed)
+		for _, question := range questions {
+			q := prepared(t, question)
+			qt, _ := q.questionType()
+
+			var answer []string
+			quiet(t, func() { answer = qt.Ask("p", q) })
+			if !qt.Check(q, answer) {
+				t.Errorf("seed %d: %s answer %q is scored wrong", seed, q.typeName(), answer)
+			}
+			if q.typeName() == Single {
+				if o, _ := q.Option(answer[0]); o.Text != "four" {
+					t.Errorf("seed %d: answer %q is option %q, want four", seed, answer, o.Text)
+				}
+			}
+		}
+	}
+}
+
+func TestSeedRepeatsOrder(t *testing.T) {
+	opts := options("a", "b", "c", "d", "e", "f", "g", "h")
+	questions := []Question{{ID: "1"}, {ID: "2"}, {ID: "3"}, {ID: "4"}, {ID: "5"}}
+	draw := func(seed int64) ([]string, []string) {
+		Seed(seed)
+		shown := texts(shuffle(opts))
+		var picked []string
+		for _, q := range Pick(questions, 3) {
+			picked = append(picked, q.ID)
+		}
+		return shown, picked
+	}
+
+	shown, picked := draw(42)
+	again, pickedAgain := draw(42)
+	if !reflect.DeepEqual(shown, again) || !reflect.DeepEqual(picked, pickedAgain) {
+		t.Errorf("seed 42 gave %q and %q, then %q and %q", shown, picked, again, pickedAgain)
+	}
+	if other, _ := draw(7); reflect.DeepEqual(shown, other) {
+		t.Errorf("seeds 42 and 7 both gave %q", shown)
+	}
+	if len(picked) != 3 {
+		t.Errorf("picked %d questions, want 3", len(picked))
+	}
+	if texts(opts)[0] != "a" {
+		t.Error("shuffling changed the question's own options")
+	}
+}
+
+func TestLoadPool(t *testing.T) {
+	dir := t.TempDir()
+	pool := `topic: basics
+questions:
+  - prompt: Which command runs a program?
+    options: [go vet, go run]
+    answer: go run
+  - id: basics.order
+    type: ordering
+    prompt: Put the steps in order
+    options: [write, build, run]
+`
+	if err := os.WriteFile(dir+"/basics.yaml", []byte(pool), 0644); err != nil {
+		t.Fatal(err)
+	}
+	p, err := LoadPool(os.DirFS(dir), "basics.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := []string{p.Questions[0].ID, p.Questions[1].ID}; !reflect.DeepEqual(got, []string{"basics.pool.1", "basics.order"}) {
+		t.Errorf("question IDs are %q", got)
+	}
+	if o, _ := p.Questions[0].Option("b"); !o.Correct {
+		t.Error("answer go run is not marked correct")
+	}
+
+	if _, err := LoadPool(os.DirFS(dir), "missing.yaml"); err == nil {
+		t.Error("loading a missing pool succeeded")
+	}
+}
@@ -0,0 +1,230 @@
+package quiz
+
+import (
+	"fmt"
+	"gocli-teacher/utils"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Names of the built-in question types
+const (
+	Single   = "single"   // Pick the correct option
+	Multi    = "multi"    // Pick every correct option
+	Text     = "text"     // Type an answer matching Answers or Pattern
+	Ordering = "ordering" // Put Options in their correct order
+	BugLine  = "bug-line" // Pick the line of Code that has the bug
+)
+
+// Type asks and checks one kind of question. Questions are prepared before
+// they are asked, so every option has an ID.
+type Type interface {
+	// Validate reports problems with the question's definition
+	Validate(q *Question) error
+	// Ask shows the prompt and reads the learner's answer.
+	// In test mode it answers correctly.
+	Ask(prompt string, q *Question) []string
+	// Check reports whether an answer is correct
+	Check(q *Question, answer []string) bool
+}
+
+// types holds the registered question types by name
+var types = map[string]Type{
+	Single:   singleChoice{},
+	Multi:    multiSelect{},
+	Text:     freeText{},
+	Ordering: ordering{},
+	BugLine:  bugLine{},
+}
+
+// RegisterType adds a question type, replacing any type with the same name
+func RegisterType(name string, t Type) {
+	types[name] = t
+}
+
+// singleChoice asks for exactly one of the options
+type singleChoice struct{}
+
+func (singleChoice) Validate(q *Question) error {
+	if len(q.Options) == 0 {
+		return fmt.Errorf("has no options")
+	}
+	if len(correctIndexes(q.Options)) != 1 {
+		return fmt.Errorf("needs exactly one correct option")
+	}
+	return nil
+}
+
+func (singleChoice) Ask(prompt string, q *Question) []string {
+	shown := shuffle(q.Options)
+	choice := utils.AskChoice(withCode(prompt, q), texts(shown), correctIndexes(shown)[0])
+	return []string{shown[choice].ID}
+}
+
+func (singleChoice) Check(q *Question, answer []string) bool {
+	if len(answer) != 1 {
+		return false
+	}
+	option, ok := q.Option(answer[0])
+	return ok && option.Correct
+}
+
+// multiSelect asks for every correct option
+type multiSelect struct{}
+
+func (multiSelect) Validate(q *Question) error {
+	if len(q.Options) == 0 {
+		return fmt.Errorf("has no options")
+	}
+	if len(correctIndexes(q.Options)) == 0 {
+		return fmt.Errorf("has no correct options")
+	}
+	return nil
+}
+
+func (multiSelect) Ask(prompt string, q *Question) []string {
+	shown := shuffle(q.Options)
+	choices := utils.AskMultipleChoices(withCode(prompt, q), texts(shown), correctIndexes(shown))
+	return ids(shown, choices)
+}
+
+// Check accepts the correct options in any order, each once
+func (multiSelect) Check(q *Question, answer []string) bool {
+	if len(answer) != len(correctIndexes(q.Options)) {
+		return false
+	}
+	chosen := make(map[string]bool)
+	for _, id := range answer {
+		if option, ok := q.Option(id); !ok || !option.Correct || chosen[id] {
+			return false
+		}
+		chosen[id] = true
+	}
+	return true
+}
+
+// freeText asks for a typed answer
+type freeText struct{}
+
+func (freeText) Validate(q *Question) error {
+	if len(q.Answers) == 0 && q.Pattern == "" {
+		return fmt.Errorf("has no accepted answers or pattern")
+	}
+	if q.Pattern != "" {
+		if _, err := regexp.Compile(q.Pattern); err != nil {
+			return fmt.Errorf("invalid pattern: %w", err)
+		}
+	}
+	return nil
+}
+
+func (freeText) Ask(prompt string, q *Question) []string {
+	fmt.Println(withCode(prompt, q))
+	if utils.TestMode && len(q.Answers) > 0 {
+		fmt.Printf("Your answer: Test mode - Using '%s'\n", q.Answers[0])
+		return []string{q.Answers[0]}
+	}
+	return []string{utils.AskForInput("Your answer", "")}
+}
+
+// Check accepts answers equal to an accepted answer, ignoring case and
+// surrounding space, or matching the pattern
+func (freeText) Check(q *Question, answer []string) bool {
+	if len(answer) != 1 {
+		return false
+	}
+	given := strings.TrimSpace(answer[0])
+	for _, accepted := range q.Answers {
+		if strings.EqualFold(given, strings.TrimSpace(accepted)) {
+			return true
+		}
+	}
+	if q.Pattern != "" {
+		if re, err := regexp.Compile(q.Pattern); err == nil && re.MatchString(given) {
+			return true
+		}
+	}
+	return false
+}
+
+// ordering asks for the options in their correct order
+type ordering struct{}
+
+func (ordering) Validate(q *Question) error {
+	if len(q.Options) < 2 {
+		return fmt.Errorf("needs at least two options to order")
+	}
+	return nil
+}
+
+// Ask shows the options shuffled, making sure they are not already in order
+func (ordering) Ask(prompt string, q *Question) []string {
+	shown := shuffle(q.Options)
+	if shown[0].ID == q.Options[0].ID {
+		shown = append(shown[1:], shown[0])
+	}
+
+	// The correct order as indexes into the shown options
+	var order []int
+	for _, o := range q.Options {
+		for i, s := range shown {
+			if s.ID == o.ID {
+				order = append(order, i)
+			}
+		}
+	}
+
+	return ids(shown, utils.AskOrder(withCode(prompt, q), texts(shown), order))
+}
+
+func (ordering) Check(q *Question, answer []string) bool {
+	if len(answer) != len(q.Options) {
+		return false
+	}
+	for i := range answer {
+		if answer[i] != q.Options[i].ID {
+			return false
+		}
+	}
+	return true
+}
+
+// bugLine asks which line of a code sample has the bug
+type bugLine struct{}
+
+func (bugLine) Validate(q *Question) error {
+	if q.Code == "" {
+		return fmt.Errorf("has no code")
+	}
+	if lines := codeLines(q.Code); q.Line < 1 || q.Line > lines {
+		return fmt.Errorf("line %d is outside its %d lines of code", q.Line, lines)
+	}
+	return nil
+}
+
+func (bugLine) Ask(prompt string, q *Question) []string {
+	withCode(prompt, q)
+	line := utils.AskNumber("Which line has the bug?", 1, codeLines(q.Code), q.Line)
+	return []string{strconv.Itoa(line)}
+}
+
+func (bugLine) Check(q *Question, answer []string) bool {
+	return len(answer) == 1 && answer[0] == strconv.Itoa(q.Line)
+}
+
+// withCode shows the prompt and the question's code, if it has any, and
+// returns what is left to print above the options
+func withCode(prompt string, q *Question) string {
+	if q.Code == "" {
+		return prompt
+	}
+	fmt.Println(prompt)
+	utils.PrintCodeWithLineNumbers(strings.TrimRight(q.Code, "\n"))
+	return ""
+}
+
+// codeLines returns the number of lines in a code sample
+func codeLines(code string) int {
+	return len(strings.Split(strings.TrimRight(code, "\n"), "\n"))
+}
@@ -0,0 +1,176 @@
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Validate checks that every prerequisite and learning path item refers to
+// a registered entry, that paths take each item after its prerequisites,
+// that quiz question IDs are unique and that prerequisites do not form a
+// cycle
+func Validate() error {
+	entries := Entries()
+	byID := make(map[string]Info)
+	for _, e := range entries {
+		byID[e.ID] = e
+	}
+
+	var errs []error
+	for _, e := range entries {
+		for _, prereq := range e.Prerequisites {
+			if _, ok := byID[prereq]; !ok {
+				errs = append(errs, fmt.Errorf("%s requires unknown lesson %s", e.ID, prereq))
+			}
+		}
+	}
+
+	for _, p := range Paths() {
+		errs = append(errs, checkPath(p, byID)...)
+	}
+
+	questions := make(map[string]string)
+	for _, t := range Tutorials() {
+		for _, q := range QuestionPool(t.ID) {
+			if other, exists := questions[q.ID]; exists {
+				errs = append(errs, fmt.Errorf("question %s is used by both %s and %s", q.ID, other, t.ID))
+			}
+			questions[q.ID] = t.ID
+		}
+	}
+
+	if cycle := findCycle(entries, byID); cycle != nil {
+		errs = append(errs, fmt.Errorf("prerequisite cycle: %s", strings.Join(cycle, " -> ")))
+	}
+
+	return errors.Join(errs...)
+}
+
+// checkPath reports items of a path that are unknown or that come before
+// one of their prerequisites, as following the path would then mean
+// starting a lesson that is not ready
+func checkPath(p Path, byID map[string]Info) []error {
+	var errs []error
+	taken := make(map[string]bool)
+	for _, item := range p.Items {
+		info, ok := byID[item]
+		if !ok {
+			errs = append(errs, fmt.Errorf("path %s includes unknown lesson %s", p.ID, item))
+			continue
+		}
+		for _, prereq := range info.Prerequisites {
+			if !taken[prereq] {
+				errs = append(errs, fmt.Errorf("path %s includes %s before its prerequisite %s", p.ID, item, prereq))
+			}
+		}
+		taken[item] = true
+	}
+	return errs
+}
+
+// findCycle returns the IDs along a prerequisite cycle, or nil if there is none
+func findCycle(entries []Info, byID map[string]Info) []string {
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make(map[string]int)
+	var stack []string
+
+	var visit func(id string) []string
+	visit = func(id string) []string {
+		state[id] = visiting
+		stack = append(stack, id)
+
+		for _, prereq := range byID[id].Prerequisites {
+			if _, ok := byID[prereq]; !ok {
+				continue
+			}
+			switch state[prereq] {
+			case visiting:
+				// Report the loop from the first visit of prereq
+				for i, s := range stack {
+					if s == prereq {
+						return append(append([]string(nil), stack[i:]...), prereq)
+					}
+				}
+			case unvisited:
+				if cycle := visit(prereq); cycle != nil {
+					return cycle
+				}
+			}
+		}
+
+		stack = stack[:len(stack)-1]
+		state[id] = done
+		return nil
+	}
+
+	for _, e := range entries {
+		if state[e.ID] == unvisited {
+			if cycle := visit(e.ID); cycle != nil {
+				return cycle
+			}
+		}
+	}
+	return nil
+}
+
+// RecommendedOrder returns every tutorial and exercise so that each comes
+// after its prerequisites. Among entries that are ready at the same time,
+// lower Order values come first, then tutorials before exercises. Entries
+// caught in a cycle are appended at the end.
+func RecommendedOrder() []Info {
+	entries := Entries()
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].Order != entries[j].Order {
+			return entries[i].Order < entries[j].Order
+		}
+		return entries[i].Kind == KindTutorial && entries[j].Kind == KindExercise
+	})
+
+	known := make(map[string]bool)
+	for _, e := range entries {
+		known[e.ID] = true
+	}
+
+	var ordered []Info
+	placed := make(map[string]bool)
+	for len(ordered) < len(entries) {
+		progress := false
+		for _, e := range entries {
+			if placed[e.ID] || !prerequisitesPlaced(e, placed, known) {
+				continue
+			}
+			ordered = append(ordered, e)
+			placed[e.ID] = true
+			progress = true
+			// Restart so earlier entries that just became ready go first
+			break
+		}
+
+		if !progress {
+			for _, e := range entries {
+				if !placed[e.ID] {
+					ordered = append(ordered, e)
+					placed[e.ID] = true
+				}
+			}
+		}
+	}
+
+	return ordered
+}
+
+// prerequisitesPlaced reports whether all known prerequisites of e are placed
+func prerequisitesPlaced(e Info, placed, known map[string]bool) bool {
+	for _, prereq := range e.Prerequisites {
+		if known[prereq] && !placed[prereq] {
+			return false
+		}
+	}
+	return true
+}
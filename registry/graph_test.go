@@ -0,0 +1,117 @@
+package registry
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// graph returns entries with the given prerequisites, keyed by ID
+func graph(prerequisites map[string][]string) map[string]Info {
+	byID := make(map[string]Info)
+	for id, prereqs := range prerequisites {
+		byID[id] = Info{ID: id, Prerequisites: prereqs}
+	}
+	return byID
+}
+
+func TestCheckPath(t *testing.T) {
+	byID := graph(map[string][]string{
+		"basics":   nil,
+		"flags":    {"basics"},
+		"commands": {"flags"},
+		"exercise": {"commands"},
+	})
+
+	tests := []struct {
+		name  string
+		items []string
+		want  []string
+	}{
+		{"in order", []string{"basics", "flags", "commands", "exercise"}, nil},
+		{"prerequisite later", []string{"flags", "basics"}, []string{"path p includes flags before its prerequisite basics"}},
+		{"prerequisite missing", []string{"basics", "flags", "exercise"}, []string{"path p includes exercise before its prerequisite commands"}},
+		{"unknown item", []string{"basics", "quizzes"}, []string{"path p includes unknown lesson quizzes"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, err := range checkPath(Path{ID: "p", Items: tt.items}, byID) {
+				got = append(got, err.Error())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %s, want %s", fmt.Sprint(got), fmt.Sprint(tt.want))
+			}
+		})
+	}
+}
+
+// entries returns tutorials listed in the given order, each written as an
+// ID followed by its prerequisites
+func entries(lists ...[]string) []Info {
+	var infos []Info
+	for i, list := range lists {
+		infos = append(infos, Info{ID: list[0], Order: i + 1, Prerequisites: list[1:]})
+	}
+	return infos
+}
+
+func TestFindCycle(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries []Info
+		want    []string
+	}{
+		{"linear chain", entries([]string{"a"}, []string{"b", "a"}, []string{"c", "b"}), nil},
+		{"diamond", entries([]string{"a"}, []string{"b", "a"}, []string{"c", "a"}, []string{"d", "b", "c"}), nil},
+		{"unknown prerequisite", entries([]string{"a", "gone"}), nil},
+		{"self-cycle", entries([]string{"a", "a"}, []string{"b"}), []string{"a", "a"}},
+		{"longer cycle", entries([]string{"a", "c"}, []string{"b", "a"}, []string{"c", "b"}, []string{"d"}), []string{"a", "c", "b", "a"}},
+		{"cycle behind an entry", entries([]string{"x", "a"}, []string{"a", "b"}, []string{"b", "a"}), []string{"a", "b", "a"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			byID := make(map[string]Info)
+			for _, e := range tt.entries {
+				byID[e.ID] = e
+			}
+			if got := findCycle(tt.entries, byID); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecommendedOrder(t *testing.T) {
+	registered, registeredExercises := tutorials, exercises
+	defer func() { tutorials, exercises = registered, registeredExercises }()
+
+	tests := []struct {
+		name    string
+		entries []Info
+		want    []string
+	}{
+		{"linear chain", entries([]string{"c", "b"}, []string{"b", "a"}, []string{"a"}), []string{"a", "b", "c"}},
+		{"diamond", entries([]string{"d", "b", "c"}, []string{"c", "a"}, []string{"b", "a"}, []string{"a"}), []string{"a", "c", "b", "d"}},
+		{"self-cycle", entries([]string{"a", "a"}, []string{"b"}), []string{"b", "a"}},
+		{"longer cycle", entries([]string{"a", "c"}, []string{"b", "a"}, []string{"c", "b"}, []string{"d"}), []string{"d", "a", "b", "c"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tutorials, exercises = nil, nil
+			for _, e := range tt.entries {
+				tutorials = append(tutorials, Tutorial{Info: e})
+			}
+			var got []string
+			for _, e := range RecommendedOrder() {
+				got = append(got, e.ID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
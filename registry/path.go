@@ -0,0 +1,62 @@
+package registry
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Path is a named curriculum mixing tutorials and exercises
+type Path struct {
+	ID          string
+	Title       string
+	Description string
+	Order       int      // Position in listings
+	Items       []string // IDs of tutorials and exercises, in the order they are taken
+}
+
+// paths holds the registered learning paths, keyed by ID
+var paths = make(map[string]Path)
+
+// RegisterPath adds a learning path to the registry.
+// It fails if a path with the same ID is already registered.
+func RegisterPath(p Path) error {
+	if p.ID == "" {
+		return fmt.Errorf("registry: path has no ID")
+	}
+	if _, exists := paths[p.ID]; exists {
+		return fmt.Errorf("registry: path %s is registered twice", p.ID)
+	}
+	paths[p.ID] = p
+	return nil
+}
+
+// Paths returns all registered learning paths in display order
+func Paths() []Path {
+	var result []Path
+	for _, p := range paths {
+		result = append(result, p)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Order != result[j].Order {
+			return result[i].Order < result[j].Order
+		}
+		return result[i].ID < result[j].ID
+	})
+	return result
+}
+
+// LookupPath finds a learning path by ID
+func LookupPath(id string) (Path, bool) {
+	p, ok := paths[id]
+	return p, ok
+}
+
+// PathNames returns the IDs of all learning paths, comma separated
+func PathNames() string {
+	var list []string
+	for _, p := range Paths() {
+		list = append(list, p.ID)
+	}
+	return strings.Join(list, ", ")
+}
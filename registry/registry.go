@@ -0,0 +1,242 @@
+package registry
+
+import (
+	"fmt"
+	"gocli-teacher/grader"
+	"gocli-teacher/quiz"
+	"sort"
+	"strings"
+)
+
+// Kind distinguishes tutorials from exercises
+type Kind string
+
+const (
+	KindTutorial Kind = "Tutorial"
+	KindExercise Kind = "Exercise"
+)
+
+// Info describes a tutorial or exercise
+type Info struct {
+	ID            string   // Key used for progress tracking
+	Name          string   // Name shown to users, defaults to the ID
+	Aliases       []string // Other names accepted on the command line
+	Description   string
+	Difficulty    string
+	Version       string   // Version of the content, recorded with quiz answers
+	Order         int      // Position in listings
+	Prerequisites []string // IDs of tutorials or exercises to finish first
+	Kind          Kind     // Set when the entry is registered
+}
+
+// DisplayName returns the name shown to users
+func (i Info) DisplayName() string {
+	if i.Name != "" {
+		return i.Name
+	}
+	return i.ID
+}
+
+// matches reports whether name refers to this entry
+func (i Info) matches(name string) bool {
+	if name == i.ID || name == i.Name {
+		return true
+	}
+	for _, alias := range i.Aliases {
+		if name == alias {
+			return true
+		}
+	}
+	return false
+}
+
+// Tutorial is a registered tutorial
+type Tutorial struct {
+	Info
+	Questions []quiz.Question             // Questions asked by the tutorial's quiz
+	Run       func() (bool, quiz.Results) // Returns true if the tutorial was completed, and the quiz answers
+}
+
+// Exercise is a registered exercise
+type Exercise struct {
+	Info
+	Directory string      // Subdirectory of the workspace the learner's program is written to
+	Solution  string      // Reference solution, a complete main.go
+	Spec      grader.Spec // Test cases and rules a solution has to pass
+
+	// Run walks through the exercise with the program in dir, passing graded
+	// the report of every grading. It returns completion and a score out of 100.
+	Run func(dir string, graded func(grader.Report)) (bool, int)
+}
+
+var (
+	tutorials     []Tutorial
+	exercises     []Exercise
+	ids           = make(map[string]bool)            // IDs are unique across tutorials and exercises
+	tutorialNames = make(map[string]string)          // Maps tutorial names to the ID that claimed them
+	exerciseNames = make(map[string]string)          // Maps exercise names to the ID that claimed them
+	pools         = make(map[string][]quiz.Question) // Maps tutorial IDs to extra quiz questions
+)
+
+// RegisterTutorial adds a tutorial to the registry.
+// It fails if the tutorial's ID or one of its names is already registered.
+func RegisterTutorial(t Tutorial) error {
+	t.Kind = KindTutorial
+	if err := claimNames(t.Info, tutorialNames); err != nil {
+		return err
+	}
+	tutorials = append(tutorials, t)
+	return nil
+}
+
+// RegisterExercise adds an exercise to the registry.
+// It fails if the exercise's ID or one of its names is already registered.
+func RegisterExercise(e Exercise) error {
+	e.Kind = KindExercise
+	if err := claimNames(e.Info, exerciseNames); err != nil {
+		return err
+	}
+	exercises = append(exercises, e)
+	return nil
+}
+
+// claimNames records the ID and names of an entry, rejecting duplicates
+func claimNames(info Info, names map[string]string) error {
+	if info.ID == "" {
+		return fmt.Errorf("registry: entry has no ID")
+	}
+	if ids[info.ID] {
+		return fmt.Errorf("registry: %s is registered twice", info.ID)
+	}
+
+	all := append([]string{info.ID, info.Name}, info.Aliases...)
+	for _, name := range all {
+		if owner, exists := names[name]; exists && name != "" {
+			return fmt.Errorf("registry: name %q of %s is already used by %s", name, info.ID, owner)
+		}
+	}
+
+	ids[info.ID] = true
+	for _, name := range all {
+		if name != "" {
+			names[name] = info.ID
+		}
+	}
+	return nil
+}
+
+// Tutorials returns all registered tutorials in display order
+func Tutorials() []Tutorial {
+	result := append([]Tutorial(nil), tutorials...)
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Order < result[j].Order
+	})
+	return result
+}
+
+// Exercises returns all registered exercises in display order
+func Exercises() []Exercise {
+	result := append([]Exercise(nil), exercises...)
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Order < result[j].Order
+	})
+	return result
+}
+
+// LookupTutorial finds a tutorial by ID, name or alias
+func LookupTutorial(name string) (Tutorial, bool) {
+	for _, t := range tutorials {
+		if t.matches(name) {
+			return t, true
+		}
+	}
+	return Tutorial{}, false
+}
+
+// LookupExercise finds an exercise by ID, name or alias
+func LookupExercise(name string) (Exercise, bool) {
+	for _, e := range exercises {
+		if e.matches(name) {
+			return e, true
+		}
+	}
+	return Exercise{}, false
+}
+
+// AddQuestions adds questions to the quiz pool of a registered tutorial
+func AddQuestions(tutorialID string, questions []quiz.Question) error {
+	if _, ok := Get(tutorialID); !ok {
+		return fmt.Errorf("registry: questions added to unknown tutorial %s", tutorialID)
+	}
+	pools[tutorialID] = append(pools[tutorialID], questions...)
+	return nil
+}
+
+// QuestionPool returns a tutorial's own quiz questions followed by the
+// extra questions added to its pool
+func QuestionPool(tutorialID string) []quiz.Question {
+	var questions []quiz.Question
+	for _, t := range tutorials {
+		if t.ID == tutorialID {
+			questions = append(questions, t.Questions...)
+		}
+	}
+	return append(questions, pools[tutorialID]...)
+}
+
+// LookupQuestion finds a quiz question by ID, along with the tutorial it is about
+func LookupQuestion(id string) (quiz.Question, Info, bool) {
+	for _, t := range tutorials {
+		for _, q := range QuestionPool(t.ID) {
+			if q.ID == id {
+				return q, t.Info, true
+			}
+		}
+	}
+	return quiz.Question{}, Info{}, false
+}
+
+// Get finds a tutorial or exercise by ID
+func Get(id string) (Info, bool) {
+	for _, t := range tutorials {
+		if t.ID == id {
+			return t.Info, true
+		}
+	}
+	for _, e := range exercises {
+		if e.ID == id {
+			return e.Info, true
+		}
+	}
+	return Info{}, false
+}
+
+// Entries returns every registered tutorial and exercise
+func Entries() []Info {
+	var entries []Info
+	for _, t := range Tutorials() {
+		entries = append(entries, t.Info)
+	}
+	for _, e := range Exercises() {
+		entries = append(entries, e.Info)
+	}
+	return entries
+}
+
+// TutorialNames returns the display names of all tutorials, comma separated
+func TutorialNames() string {
+	var list []string
+	for _, t := range Tutorials() {
+		list = append(list, t.DisplayName())
+	}
+	return strings.Join(list, ", ")
+}
+
+// ExerciseNames returns the display names of all exercises, comma separated
+func ExerciseNames() string {
+	var list []string
+	for _, e := range Exercises() {
+		list = append(list, e.DisplayName())
+	}
+	return strings.Join(list, ", ")
+}
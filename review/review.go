@@ -0,0 +1,164 @@
+package review
+
+import (
+	"encoding/json"
+	"fmt"
+	"gocli-teacher/progress"
+	"gocli-teacher/quiz"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// Card is the review schedule and answer history of one quiz question
+type Card struct {
+	QuestionID  string    `json:"question_id"`
+	EaseFactor  float64   `json:"ease_factor"`
+	Interval    int       `json:"interval_days"` // Days until the next review
+	Repetitions int       `json:"repetitions"`   // Correct reviews in a row
+	Due         time.Time `json:"due"`
+	History     []Answer  `json:"history"`
+}
+
+// Answer records one answer to a question
+type Answer struct {
+	At       time.Time     `json:"at"`
+	Correct  bool          `json:"correct"`
+	Duration time.Duration `json:"duration"`
+	Quality  int           `json:"quality"` // SM-2 grade from 0 to 5
+}
+
+// Deck manages the review schedule of missed and slow quiz questions
+type Deck struct {
+	cards     map[string]*Card
+	configDir string
+	filePath  string
+}
+
+// New loads the review deck stored next to the progress data
+func New() (*Deck, error) {
+	configDir, err := progress.ConfigDir()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get config directory: %w", err)
+	}
+
+	deck := &Deck{
+		cards:     make(map[string]*Card),
+		configDir: configDir,
+		filePath:  filepath.Join(configDir, "review.json"),
+	}
+
+	if err := deck.load(); err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to load review data: %w", err)
+	}
+
+	return deck, nil
+}
+
+// Record adds a quiz answer to the deck. Questions enter the deck when
+// they are missed or answered slowly; answers to questions already in the
+// deck update their schedule. It returns true if the question is in the deck.
+func (d *Deck) Record(result quiz.Result, now time.Time) bool {
+	quality := Quality(result.Correct, result.Duration)
+
+	card, exists := d.cards[result.QuestionID]
+	if !exists {
+		if quality >= 4 {
+			return false
+		}
+		card = &Card{QuestionID: result.QuestionID, EaseFactor: initialEase}
+		d.cards[result.QuestionID] = card
+	}
+
+	card.History = append(card.History, Answer{
+		At:       now,
+		Correct:  result.Correct,
+		Duration: result.Duration,
+		Quality:  quality,
+	})
+	card.schedule(quality, now)
+	return true
+}
+
+// RecordAll adds every answer of a quiz to the deck and saves it
+func (d *Deck) RecordAll(results quiz.Results) error {
+	now := time.Now()
+	for _, result := range results.Questions {
+		d.Record(result, now)
+	}
+	return d.save()
+}
+
+// Save stores the deck on disk
+func (d *Deck) Save() error {
+	return d.save()
+}
+
+// Due returns the cards due for review at the given time, most overdue first
+func (d *Deck) Due(now time.Time) []Card {
+	var due []Card
+	for _, card := range d.cards {
+		if !card.Due.After(now) {
+			due = append(due, *card)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool {
+		return due[i].Due.Before(due[j].Due)
+	})
+	return due
+}
+
+// NextDue returns when the next card is due, if the deck has any cards
+func (d *Deck) NextDue() (time.Time, bool) {
+	var next time.Time
+	for _, card := range d.cards {
+		if next.IsZero() || card.Due.Before(next) {
+			next = card.Due
+		}
+	}
+	return next, !next.IsZero()
+}
+
+// Len returns the number of questions in the deck
+func (d *Deck) Len() int {
+	return len(d.cards)
+}
+
+// Reset removes every card
+func (d *Deck) Reset() error {
+	d.cards = make(map[string]*Card)
+	return d.save()
+}
+
+// save stores the deck on disk
+func (d *Deck) save() error {
+	if err := os.MkdirAll(d.configDir, 0755); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(d.cards, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal review data: %w", err)
+	}
+
+	if err := os.WriteFile(d.filePath, data, 0644); err != nil {
+		return fmt.Errorf("failed to write review file: %w", err)
+	}
+
+	return nil
+}
+
+// load reads the deck from disk
+func (d *Deck) load() error {
+	data, err := os.ReadFile(d.filePath)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(data, &d.cards); err != nil {
+		return fmt.Errorf("failed to parse review data: %w", err)
+	}
+
+	return nil
+}
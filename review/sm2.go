@@ -0,0 +1,62 @@
+package review
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	// SlowAnswer is how long a correct answer can take before the question
+	// is scheduled for review
+	SlowAnswer = 20 * time.Second
+
+	// QuickAnswer is how fast a correct answer has to be to count as effortless
+	QuickAnswer = 8 * time.Second
+
+	initialEase = 2.5
+	minimumEase = 1.3
+)
+
+// Quality grades an answer on the SM-2 scale from 0 to 5.
+// Wrong answers get 1, slow correct answers 3, correct answers 4 and
+// quick correct answers 5. Answers below 4 put a question up for review.
+func Quality(correct bool, took time.Duration) int {
+	switch {
+	case !correct:
+		return 1
+	case took > SlowAnswer:
+		return 3
+	case took < QuickAnswer:
+		return 5
+	default:
+		return 4
+	}
+}
+
+// schedule updates a card with an answer of the given quality using the
+// SM-2 algorithm and sets when it is next due
+func (c *Card) schedule(quality int, now time.Time) {
+	if quality < 3 {
+		// Start over with short intervals after a lapse
+		c.Repetitions = 0
+		c.Interval = 1
+	} else {
+		switch c.Repetitions {
+		case 0:
+			c.Interval = 1
+		case 1:
+			c.Interval = 6
+		default:
+			c.Interval = int(math.Round(float64(c.Interval) * c.EaseFactor))
+		}
+		c.Repetitions++
+	}
+
+	q := float64(5 - quality)
+	c.EaseFactor += 0.1 - q*(0.08+q*0.02)
+	if c.EaseFactor < minimumEase {
+		c.EaseFactor = minimumEase
+	}
+
+	c.Due = now.AddDate(0, 0, c.Interval)
+}
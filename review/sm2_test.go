@@ -0,0 +1,102 @@
+package review
+
+import (
+	"gocli-teacher/quiz"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestQuality(t *testing.T) {
+	tests := []struct {
+		correct bool
+		took    time.Duration
+		want    int
+	}{
+		{false, time.Second, 1},
+		{false, time.Minute, 1},
+		{true, time.Second, 5},
+		{true, QuickAnswer, 4},
+		{true, SlowAnswer, 4},
+		{true, SlowAnswer + time.Second, 3},
+	}
+	for _, tt := range tests {
+		if got := Quality(tt.correct, tt.took); got != tt.want {
+			t.Errorf("Quality(%v, %s) = %d, want %d", tt.correct, tt.took, got, tt.want)
+		}
+	}
+}
+
+func TestSchedule(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name        string
+		card        Card
+		quality     int
+		interval    int
+		repetitions int
+		ease        float64
+	}{
+		{"first review", Card{EaseFactor: initialEase}, 5, 1, 1, 2.6},
+		{"second review", Card{EaseFactor: 2.6, Interval: 1, Repetitions: 1}, 5, 6, 2, 2.7},
+		{"later review", Card{EaseFactor: 2.7, Interval: 6, Repetitions: 2}, 4, 16, 3, 2.7},
+		{"slow answer", Card{EaseFactor: 2.5, Interval: 6, Repetitions: 2}, 3, 15, 3, 2.36},
+		{"reset on failure", Card{EaseFactor: 2.5, Interval: 16, Repetitions: 3}, 1, 1, 0, 1.96},
+		{"ease floor", Card{EaseFactor: 1.4, Interval: 6, Repetitions: 2}, 1, 1, 0, minimumEase},
+		{"ease floor on success", Card{EaseFactor: minimumEase, Interval: 6, Repetitions: 2}, 3, 8, 3, minimumEase},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			card := tt.card
+			card.schedule(tt.quality, now)
+			if card.Interval != tt.interval || card.Repetitions != tt.repetitions {
+				t.Errorf("interval %d after %d repetitions, want %d after %d", card.Interval, card.Repetitions, tt.interval, tt.repetitions)
+			}
+			if math.Abs(card.EaseFactor-tt.ease) > 1e-9 {
+				t.Errorf("ease factor %.2f, want %.2f", card.EaseFactor, tt.ease)
+			}
+			if want := now.AddDate(0, 0, tt.interval); !card.Due.Equal(want) {
+				t.Errorf("due %s, want %s", card.Due, want)
+			}
+		})
+	}
+}
+
+func TestDeckRecord(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	deck := &Deck{cards: make(map[string]*Card)}
+
+	// Quick correct answers to new questions are not worth reviewing
+	if deck.Record(quiz.Result{QuestionID: "known", Correct: true, Duration: time.Second}, now) {
+		t.Error("a quick correct answer put the question in the deck")
+	}
+	if !deck.Record(quiz.Result{QuestionID: "slow", Correct: true, Duration: time.Minute}, now) {
+		t.Error("a slow answer did not put the question in the deck")
+	}
+	if !deck.Record(quiz.Result{QuestionID: "missed", Correct: false, Duration: time.Second}, now) {
+		t.Error("a wrong answer did not put the question in the deck")
+	}
+	if deck.Len() != 2 {
+		t.Fatalf("deck holds %d cards, want 2", deck.Len())
+	}
+	if due := deck.Due(now.AddDate(0, 0, 1)); len(due) != 2 {
+		t.Errorf("%d cards due the next day, want 2", len(due))
+	}
+
+	// A quick correct answer to a question in the deck moves it further out
+	later := now.AddDate(0, 0, 1)
+	if !deck.Record(quiz.Result{QuestionID: "missed", Correct: true, Duration: time.Second}, later) {
+		t.Error("answering a question in the deck took it out")
+	}
+	card := deck.cards["missed"]
+	if len(card.History) != 2 || card.History[1].Quality != 5 || !card.History[1].At.Equal(later) {
+		t.Errorf("history %+v, want a second answer of quality 5", card.History)
+	}
+	if card.Repetitions != 1 || !card.Due.Equal(later.AddDate(0, 0, 1)) {
+		t.Errorf("%d repetitions due %s, want 1 due the day after", card.Repetitions, card.Due)
+	}
+	if next, ok := deck.NextDue(); !ok || !next.Equal(now.AddDate(0, 0, 1)) {
+		t.Errorf("next due %s, want the slow question's %s", next, now.AddDate(0, 0, 1))
+	}
+}
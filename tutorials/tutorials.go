@@ -0,0 +1,75 @@
+package tutorials
+
+import (
+	"errors"
+	"fmt"
+	"gocli-teacher/lessons"
+	"gocli-teacher/quiz"
+	"gocli-teacher/registry"
+	"io/fs"
+)
+
+// Register loads every lesson in the tutorials directory of fsys and adds
+// it to the registry. Lessons that fail to load are skipped and reported
+// in the returned error.
+func Register(fsys fs.FS) error {
+	paths, err := fs.Glob(fsys, "tutorials/*.yaml")
+	if err != nil {
+		return fmt.Errorf("failed to list tutorials: %w", err)
+	}
+
+	var errs []error
+	for _, path := range paths {
+		lesson, err := lessons.Load(fsys, path)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		err = registry.RegisterTutorial(registry.Tutorial{
+			Info: registry.Info{
+				ID:            lesson.ID,
+				Name:          lesson.Name,
+				Aliases:       lesson.Aliases,
+				Description:   lesson.Description,
+				Version:       lesson.Version,
+				Order:         lesson.Order,
+				Prerequisites: lesson.Prerequisites,
+			},
+			Questions: lesson.Quiz.Questions,
+			Run: func() (bool, quiz.Results) {
+				return lessons.Run(lesson)
+			},
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", path, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// RegisterQuizzes loads every question pool in the quizzes directory of fsys
+// and adds its questions to the pool of its tutorial. Tutorials have to be
+// registered first.
+func RegisterQuizzes(fsys fs.FS) error {
+	paths, err := fs.Glob(fsys, "quizzes/*.yaml")
+	if err != nil {
+		return fmt.Errorf("failed to list question pools: %w", err)
+	}
+
+	var errs []error
+	for _, path := range paths {
+		pool, err := quiz.LoadPool(fsys, path)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		if err := registry.AddQuestions(pool.Topic, pool.Questions); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", path, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -37,33 +37,32 @@ func ReadExerciseFile(filepath string) (string, error) {
         return string(content), nil
 }
 
-// ValidateGoCode performs a simple validation of Go code
-// This is a basic validator that checks for common syntax issues
-func ValidateGoCode(code string) (bool, []string) {
-        var errors []string
-        
-        // Check for unbalanced curly braces
-        openBraces := strings.Count(code, "{")
-        closeBraces := strings.Count(code, "}")
-        if openBraces != closeBraces {
-                errors = append(errors, fmt.Sprintf("Unbalanced curly braces: %d open, %d close", openBraces, closeBraces))
-        }
-        
-        // Check for unbalanced parentheses
-        openParens := strings.Count(code, "(")
-        closeParens := strings.Count(code, ")")
-        if openParens != closeParens {
-                errors = append(errors, fmt.Sprintf("Unbalanced parentheses: %d open, %d close", openParens, closeParens))
-        }
-        
-        // Check for package declaration
-        if !strings.Contains(code, "package ") {
-                errors = append(errors, "Missing package declaration")
-        }
-        
-        // More validations could be added here
-        
-        return len(errors) == 0, errors
+// CopyDir copies the files and directories under src to dst, creating dst
+// if it doesn't exist
+func CopyDir(src, dst string) error {
+        return filepath.WalkDir(src, func(path string, entry os.DirEntry, err error) error {
+                if err != nil {
+                        return err
+                }
+                rel, err := filepath.Rel(src, path)
+                if err != nil {
+                        return err
+                }
+                target := filepath.Join(dst, rel)
+                
+                if entry.IsDir() {
+                        return os.MkdirAll(target, 0755)
+                }
+                content, err := os.ReadFile(path)
+                if err != nil {
+                        return fmt.Errorf("failed to read file: %w", err)
+                }
+                err = os.WriteFile(target, content, 0644)
+                if err != nil {
+                        return fmt.Errorf("failed to write file: %w", err)
+                }
+                return nil
+        })
 }
 
 // GenerateMainPackage generates a simple main package Go file
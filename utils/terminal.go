@@ -3,10 +3,13 @@ package utils
 import (
         "bufio"
         "fmt"
+        "io"
         "os"
         "os/exec"
         "runtime"
         "strings"
+
+        "golang.org/x/term"
 )
 
 // TestMode indicates whether we are running in non-interactive test mode
@@ -34,6 +37,25 @@ func ClearScreen() {
         }
 }
 
+// ColorEnabled reports whether output to f can be colored: f is a terminal
+// and the NO_COLOR environment variable is not set
+func ColorEnabled(f *os.File) bool {
+        if TestMode || os.Getenv("NO_COLOR") != "" {
+                return false
+        }
+        return term.IsTerminal(int(f.Fd()))
+}
+
+// TerminalWidth returns the number of columns of the terminal f writes to,
+// or fallback if f is not a terminal
+func TerminalWidth(f *os.File, fallback int) int {
+        width, _, err := term.GetSize(int(f.Fd()))
+        if err != nil || width <= 0 {
+                return fallback
+        }
+        return width
+}
+
 // PrintTitle prints a title with formatting
 func PrintTitle(title string) {
         fmt.Println(strings.Repeat("=", len(title)+4))
@@ -66,10 +88,9 @@ func AskYesNo(question string) bool {
         
         for {
                 fmt.Printf("%s (y/n): ", question)
-                input, err := reader.ReadString('\n')
+                input, err := readAnswer(reader)
                 if err != nil {
-                        fmt.Println("Error reading input:", err)
-                        continue
+                        stopAsking(err)
                 }
                 
                 input = strings.TrimSpace(strings.ToLower(input))
@@ -86,27 +107,32 @@ func AskYesNo(question string) bool {
 }
 
 // AskQuestion asks a multiple-choice question and returns the selected answer
-// In test mode, it automatically selects the correct answer (first option)
+// In test mode, it automatically selects the first option
 func AskQuestion(question string, options []string) string {
+        return options[AskChoice(question, options, 0)]
+}
+
+// AskChoice asks a multiple-choice question and returns the index of the selected option
+// In test mode, it automatically selects the option at testChoice
+func AskChoice(question string, options []string, testChoice int) int {
         fmt.Println(question)
         for i, option := range options {
                 fmt.Printf("%d. %s\n", i+1, option)
         }
         
-        // In test mode, always select the correct answer (first option)
+        // In test mode, select the given option
         if TestMode {
-                fmt.Printf("\nTest mode: Automatically selecting option 1\n")
-                return options[0]
+                fmt.Printf("\nTest mode: Automatically selecting option %d\n", testChoice+1)
+                return testChoice
         }
         
         reader := bufio.NewReader(os.Stdin)
         
         for {
                 fmt.Print("\nEnter your choice (1-" + fmt.Sprint(len(options)) + "): ")
-                input, err := reader.ReadString('\n')
+                input, err := readAnswer(reader)
                 if err != nil {
-                        fmt.Println("Error reading input:", err)
-                        continue
+                        stopAsking(err)
                 }
                 
                 input = strings.TrimSpace(input)
@@ -117,7 +143,7 @@ func AskQuestion(question string, options []string) string {
                         continue
                 }
                 
-                return options[choice-1]
+                return choice - 1
         }
 }
 
@@ -157,3 +183,151 @@ func AskForInput(prompt string, defaultValue string) string {
         
         return input
 }
+
+// AskMultipleChoices asks a question that accepts several options and returns the indexes of the selected ones
+// In test mode, it automatically selects the options at testChoices
+func AskMultipleChoices(question string, options []string, testChoices []int) []int {
+        fmt.Println(question)
+        for i, option := range options {
+                fmt.Printf("%d. %s\n", i+1, option)
+        }
+        
+        // In test mode, select the given options
+        if TestMode {
+                fmt.Printf("\nTest mode: Automatically selecting options %s\n", formatChoices(testChoices))
+                return testChoices
+        }
+        
+        reader := bufio.NewReader(os.Stdin)
+        
+        for {
+                fmt.Print("\nEnter all choices that apply, separated by spaces or commas (e.g. 1,3): ")
+                input, err := readAnswer(reader)
+                if err != nil {
+                        stopAsking(err)
+                }
+                
+                choices, ok := parseChoices(input, len(options))
+                if !ok || len(choices) == 0 {
+                        fmt.Println("Invalid choice. Please enter numbers between 1 and", len(options))
+                        continue
+                }
+                
+                return choices
+        }
+}
+
+// AskOrder asks the user to put options in order and returns their indexes in the chosen order
+// In test mode, it automatically enters testOrder
+func AskOrder(question string, options []string, testOrder []int) []int {
+        fmt.Println(question)
+        for i, option := range options {
+                fmt.Printf("%d. %s\n", i+1, option)
+        }
+        
+        // In test mode, enter the given order
+        if TestMode {
+                fmt.Printf("\nTest mode: Automatically entering %s\n", formatChoices(testOrder))
+                return testOrder
+        }
+        
+        reader := bufio.NewReader(os.Stdin)
+        
+        for {
+                fmt.Print("\nEnter the numbers in the correct order (e.g. 3 1 2): ")
+                input, err := readAnswer(reader)
+                if err != nil {
+                        stopAsking(err)
+                }
+                
+                choices, ok := parseChoices(input, len(options))
+                if !ok || len(choices) != len(options) {
+                        fmt.Println("Invalid order. Please enter each number from 1 to", len(options), "once")
+                        continue
+                }
+                
+                return choices
+        }
+}
+
+// AskNumber asks for a whole number between min and max
+// In test mode, it automatically returns testValue
+func AskNumber(prompt string, min, max, testValue int) int {
+        if TestMode {
+                fmt.Printf("%s (%d-%d): Test mode - Using %d\n", prompt, min, max, testValue)
+                return testValue
+        }
+        
+        reader := bufio.NewReader(os.Stdin)
+        
+        for {
+                fmt.Printf("%s (%d-%d): ", prompt, min, max)
+                input, err := readAnswer(reader)
+                if err != nil {
+                        stopAsking(err)
+                }
+                
+                number := 0
+                _, err = fmt.Sscanf(strings.TrimSpace(input), "%d", &number)
+                if err != nil || number < min || number > max {
+                        fmt.Printf("Invalid number. Please enter a number between %d and %d\n", min, max)
+                        continue
+                }
+                
+                return number
+        }
+}
+
+// readAnswer reads a line typed in answer to a prompt. A last line without
+// a newline is still an answer; once input has ended, io.EOF is returned
+// since asking again can never get one.
+func readAnswer(reader *bufio.Reader) (string, error) {
+        input, err := reader.ReadString('\n')
+        if err == io.EOF && strings.TrimSpace(input) != "" {
+                return input, nil
+        }
+        return input, err
+}
+
+// stopAsking exits when a question can't be answered because reading the
+// input failed
+func stopAsking(err error) {
+        if err == io.EOF {
+                fmt.Fprintln(os.Stderr, "\nError: Input ended before the question was answered")
+        } else {
+                fmt.Fprintf(os.Stderr, "\nError: Failed to read input: %s\n", err)
+        }
+        os.Exit(1)
+}
+
+// formatChoices formats option indexes as the numbers shown to the user
+func formatChoices(choices []int) string {
+        var numbers []string
+        for _, choice := range choices {
+                numbers = append(numbers, fmt.Sprint(choice+1))
+        }
+        return strings.Join(numbers, " ")
+}
+
+// parseChoices parses option numbers separated by spaces or commas into indexes.
+// It fails if a number is out of range or repeated.
+func parseChoices(input string, count int) ([]int, bool) {
+        fields := strings.FieldsFunc(input, func(r rune) bool {
+                return r == ',' || r == ' ' || r == '\t' || r == '\n' || r == '\r'
+        })
+        
+        seen := make(map[int]bool)
+        var choices []int
+        for _, field := range fields {
+                choice := 0
+                if _, err := fmt.Sscanf(field, "%d", &choice); err != nil {
+                        return nil, false
+                }
+                if choice < 1 || choice > count || seen[choice] {
+                        return nil, false
+                }
+                seen[choice] = true
+                choices = append(choices, choice-1)
+        }
+        return choices, true
+}
@@ -0,0 +1,30 @@
+package utils
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestReadAnswer(t *testing.T) {
+	reader := bufio.NewReader(strings.NewReader("1,3\n\n2"))
+	for _, want := range []string{"1,3\n", "\n", "2"} {
+		if got, err := readAnswer(reader); err != nil || got != want {
+			t.Errorf("got %q, %v; want %q", got, err, want)
+		}
+	}
+
+	// Asking again after the input ended gets no answer
+	for i := 0; i < 2; i++ {
+		if got, err := readAnswer(reader); err != io.EOF {
+			t.Errorf("after the input ended: got %q, %v; want io.EOF", got, err)
+		}
+	}
+	if _, err := readAnswer(bufio.NewReader(strings.NewReader("\n"))); err != nil {
+		t.Errorf("a blank line: got error %v", err)
+	}
+	if _, err := readAnswer(bufio.NewReader(strings.NewReader(" "))); err != io.EOF {
+		t.Errorf("spaces at the end of the input: got error %v, want io.EOF", err)
+	}
+}
@@ -0,0 +1,223 @@
+package utils
+
+import (
+        "errors"
+        "fmt"
+        "go/ast"
+        "go/importer"
+        "go/parser"
+        "go/scanner"
+        "go/token"
+        "go/types"
+        "path"
+        "regexp"
+        "sort"
+        "strings"
+)
+
+// Severity says whether a diagnostic stops the code from building
+type Severity string
+
+const (
+        SeverityError   Severity = "error"
+        SeverityWarning Severity = "warning"
+)
+
+// Diagnostic is a problem found in Go code
+type Diagnostic struct {
+        File     string
+        Line     int
+        Column   int
+        Severity Severity
+        Message  string
+}
+
+// String formats the diagnostic as file:line:column: severity: message
+func (d Diagnostic) String() string {
+        return fmt.Sprintf("%s:%d:%d: %s: %s", d.File, d.Line, d.Column, d.Severity, d.Message)
+}
+
+// HasErrors reports whether any of the diagnostics is an error
+func HasErrors(diagnostics []Diagnostic) bool {
+        for _, d := range diagnostics {
+                if d.Severity == SeverityError {
+                        return true
+                }
+        }
+        return false
+}
+
+// ValidateGoCode parses and type-checks the source of a main package file.
+// It finds syntax errors, unused imports and variables, undefined names,
+// type errors and a missing main function without running the compiler.
+// Packages outside the standard library are not loaded, so their uses are
+// not checked, unused ones are not found, and names that may come from them
+// are not reported as undefined. The compiler has the final say.
+func ValidateGoCode(filename, code string) []Diagnostic {
+        fset := token.NewFileSet()
+        file, err := parser.ParseFile(fset, filename, code, parser.ParseComments)
+        if err != nil {
+                return syntaxDiagnostics(filename, err)
+        }
+
+        var diagnostics []Diagnostic
+        add := func(pos token.Pos, severity Severity, message string) {
+                p := fset.Position(pos)
+                diagnostics = append(diagnostics, Diagnostic{
+                        File:     filename,
+                        Line:     p.Line,
+                        Column:   p.Column,
+                        Severity: severity,
+                        Message:  message,
+                })
+        }
+
+        if file.Name.Name != "main" {
+                add(file.Name.Pos(), SeverityError, fmt.Sprintf("package is %s, but a program has to be package main", file.Name.Name))
+        } else if !declaresMain(file) {
+                add(file.Name.Pos(), SeverityError, "function main is undeclared in the main package")
+        }
+
+        // go/types names packages that fail to import after the last element
+        // of their path, which is wrong for paths like .../survey/v2
+        dotImport, guessed := false, false
+        for _, imp := range file.Imports {
+                if isStandard(strings.Trim(imp.Path.Value, `"`)) {
+                        continue
+                }
+                switch {
+                case imp.Name == nil:
+                        imp.Name = ast.NewIdent(PackageName(strings.Trim(imp.Path.Value, `"`)))
+                        guessed = true
+                case imp.Name.Name == ".":
+                        dotImport = true
+                }
+        }
+        qualifiers := qualifierPositions(file)
+
+        conf := types.Config{
+                Importer: stdImporter{importer.Default()},
+                Error: func(err error) {
+                        var typeErr types.Error
+                        if !errors.As(err, &typeErr) {
+                                return
+                        }
+                        if strings.Contains(typeErr.Msg, errNotStandard.Error()) {
+                                return
+                        }
+                        // A name that is not found may be in a package that was
+                        // not loaded: any name if one is dot-imported, and the
+                        // qualifier of a selector if a package name was guessed
+                        if strings.HasPrefix(typeErr.Msg, "undefined: ") && (dotImport || guessed && qualifiers[typeErr.Pos]) {
+                                return
+                        }
+                        add(typeErr.Pos, SeverityError, typeErr.Msg)
+                },
+        }
+        conf.Check(file.Name.Name, fset, []*ast.File{file}, nil)
+
+        for _, group := range file.Comments {
+                for _, c := range group.List {
+                        if strings.Contains(c.Text, "TODO") {
+                                add(c.Pos(), SeverityWarning, "TODO comment left in the code")
+                        }
+                }
+        }
+
+        sort.SliceStable(diagnostics, func(i, j int) bool {
+                if diagnostics[i].Line != diagnostics[j].Line {
+                        return diagnostics[i].Line < diagnostics[j].Line
+                }
+                return diagnostics[i].Column < diagnostics[j].Column
+        })
+        return diagnostics
+}
+
+// declaresMain reports whether a file declares a main function
+func declaresMain(file *ast.File) bool {
+        for _, decl := range file.Decls {
+                if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil && fn.Name.Name == "main" {
+                        return true
+                }
+        }
+        return false
+}
+
+// qualifierPositions returns the positions of the names on the left of a
+// selector, like fmt in fmt.Println, which may name a package
+func qualifierPositions(file *ast.File) map[token.Pos]bool {
+        positions := make(map[token.Pos]bool)
+        ast.Inspect(file, func(n ast.Node) bool {
+                if sel, ok := n.(*ast.SelectorExpr); ok {
+                        if ident, ok := sel.X.(*ast.Ident); ok {
+                                positions[ident.Pos()] = true
+                        }
+                }
+                return true
+        })
+        return positions
+}
+
+// syntaxDiagnostics converts the errors from parsing a file
+func syntaxDiagnostics(filename string, err error) []Diagnostic {
+        var list scanner.ErrorList
+        if !errors.As(err, &list) {
+                return []Diagnostic{{File: filename, Severity: SeverityError, Message: err.Error()}}
+        }
+
+        var diagnostics []Diagnostic
+        for _, e := range list {
+                diagnostics = append(diagnostics, Diagnostic{
+                        File:     filename,
+                        Line:     e.Pos.Line,
+                        Column:   e.Pos.Column,
+                        Severity: SeverityError,
+                        Message:  e.Msg,
+                })
+        }
+        return diagnostics
+}
+
+// errNotStandard is returned for imports outside the standard library
+var errNotStandard = errors.New("not a standard library package")
+
+// stdImporter imports standard library packages. Other packages fail to
+// import, and go/types then skips checking their uses.
+type stdImporter struct {
+        std types.Importer
+}
+
+// Import imports a standard library package
+func (i stdImporter) Import(path string) (*types.Package, error) {
+        if !isStandard(path) {
+                return nil, errNotStandard
+        }
+        pkg, err := i.std.Import(path)
+        if err != nil {
+                return nil, errors.New("no such package in the standard library")
+        }
+        return pkg, nil
+}
+
+// isStandard reports whether an import path can be in the standard library,
+// whose paths have no dot in their first element
+func isStandard(importPath string) bool {
+        first, _, _ := strings.Cut(importPath, "/")
+        return !strings.Contains(first, ".")
+}
+
+// versionSuffix matches the major version at the end of an import path
+var versionSuffix = regexp.MustCompile(`^v[0-9]+$`)
+
+// PackageName guesses the name of a package from its import path, like
+// survey for github.com/AlecAivazis/survey/v2 and yaml for gopkg.in/yaml.v3
+func PackageName(importPath string) string {
+        dir, name := path.Split(importPath)
+        if versionSuffix.MatchString(name) && dir != "" {
+                name = path.Base(dir)
+        }
+        if i := strings.Index(name, ".v"); i > 0 {
+                name = name[:i]
+        }
+        return strings.TrimPrefix(name, "go-")
+}
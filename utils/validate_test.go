@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateGoCode(t *testing.T) {
+	tests := []struct {
+		name string
+		code string
+		want []string // Messages of the errors expected, in order
+	}{
+		{
+			name: "valid",
+			code: "package main\n\nimport \"fmt\"\n\nfunc main() {\n\tfmt.Println(\"hi\")\n}\n",
+		},
+		{
+			name: "versioned import path",
+			code: "package main\n\nimport \"github.com/AlecAivazis/survey/v2\"\n\nfunc main() {\n\tsurvey.AskOne(nil, nil)\n}\n",
+		},
+		{
+			name: "dot import of a third-party package",
+			code: "package main\n\nimport . \"github.com/spf13/cobra\"\n\nfunc main() {\n\tcmd := &Command{}\n\t_ = cmd\n}\n",
+		},
+		{
+			name: "package name unlike its path",
+			code: "package main\n\nimport \"github.com/mattn/go-runewidth\"\n\nfunc main() {\n\twidth.Size()\n}\n",
+		},
+		{
+			name: "undefined name beside a third-party import",
+			code: "package main\n\nimport \"github.com/spf13/cobra\"\n\nfunc main() {\n\t_ = cobra.Command{}\n\tfmt := missing\n\t_ = fmt\n}\n",
+			want: []string{"undefined: missing"},
+		},
+		{
+			name: "unused variable and import",
+			code: "package main\n\nimport \"os\"\n\nfunc main() {\n\tx := 1\n}\n",
+			want: []string{`"os" imported and not used`, "declared and not used: x"},
+		},
+		{
+			name: "no main",
+			code: "package main\n\nfunc run() {}\n",
+			want: []string{"function main is undeclared in the main package"},
+		},
+		{
+			name: "syntax error",
+			code: "package main\n\nfunc main() {\n\tfmt.Println(\n}\n",
+			want: []string{"expected operand"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, d := range ValidateGoCode("main.go", tt.code) {
+				if d.Severity == SeverityError {
+					got = append(got, d.Message)
+				}
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got errors %q, want %q", got, tt.want)
+			}
+			for i := range got {
+				if !strings.Contains(got[i], tt.want[i]) {
+					t.Errorf("error %d is %q, want %q", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestValidateGoCodeWarnsAboutTODOs(t *testing.T) {
+	diagnostics := ValidateGoCode("main.go", "package main\n\nfunc main() {\n\t// TODO: greet\n}\n")
+	if len(diagnostics) != 1 || diagnostics[0].Severity != SeverityWarning || diagnostics[0].Line != 4 {
+		t.Errorf("got %v, want a warning on line 4", diagnostics)
+	}
+	if HasErrors(diagnostics) {
+		t.Error("a TODO is reported as an error")
+	}
+}
@@ -0,0 +1,132 @@
+package workspace
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"gocli-teacher/grader"
+	"gocli-teacher/utils"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// VendorEnvVar names the environment variable that points at a bundled
+// vendor directory, used when the module cache lacks what exercises need
+const VendorEnvVar = "GOCLI_TEACHER_VENDOR"
+
+// Source says where an exercise module finds its dependencies
+type Source int
+
+const (
+	ModuleCache Source = iota // The module cache holds every required module
+	Vendored                  // A bundled vendor directory was copied into the exercise
+)
+
+// ModuleFiles returns the go.mod and go.sum of an exercise module, which
+// requires the same versions as gocli-teacher and is named like the
+// programs the grader builds
+func ModuleFiles() map[string]string {
+	mod := grader.Tool.Named(grader.ModuleName)
+	return map[string]string{
+		"go.mod": string(mod.GoMod),
+		"go.sum": string(mod.GoSum),
+	}
+}
+
+// ResolveDependencies makes the exercise module in dir build without
+// network access. Modules already in the module cache are used from there;
+// otherwise the bundled vendor directory is copied into dir. The error says
+// why neither worked.
+func ResolveDependencies(dir string) (Source, error) {
+	if _, err := os.Stat(filepath.Join(dir, "vendor", "modules.txt")); err == nil {
+		return Vendored, nil
+	}
+	cacheErr := downloadOffline(dir)
+	if cacheErr == nil {
+		return ModuleCache, nil
+	}
+
+	bundle := vendorBundle()
+	if bundle == "" {
+		return ModuleCache, fmt.Errorf("%w, and no vendor directory is bundled", cacheErr)
+	}
+	if err := checkVendor(bundle, grader.Tool.Requires()); err != nil {
+		return ModuleCache, fmt.Errorf("%w, and %w", cacheErr, err)
+	}
+	if err := utils.CopyDir(bundle, filepath.Join(dir, "vendor")); err != nil {
+		return ModuleCache, fmt.Errorf("failed to copy the vendor directory: %w", err)
+	}
+	return Vendored, nil
+}
+
+// downloadOffline asks go for every module gocli-teacher requires without
+// letting it use the network, which only succeeds if the module cache
+// already holds them
+func downloadOffline(dir string) error {
+	args := []string{"mod", "download"}
+	for _, r := range grader.Tool.Requires() {
+		args = append(args, r.Path+"@"+r.Version)
+	}
+	cmd := exec.Command("go", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOPROXY=off", "GOFLAGS=-mod=mod")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		if errors.Is(err, exec.ErrNotFound) {
+			return errors.New("the go command was not found")
+		}
+		if len(out) > 0 {
+			return errors.New("the module cache lacks the packages exercises use")
+		}
+		return fmt.Errorf("failed to check the module cache: %w", err)
+	}
+	return nil
+}
+
+// vendorBundle returns the bundled vendor directory: the one VendorEnvVar
+// names, or the vendor directory next to the gocli-teacher executable.
+// It returns "" if there is none.
+func vendorBundle() string {
+	if dir := os.Getenv(VendorEnvVar); dir != "" {
+		return dir
+	}
+	executable, err := os.Executable()
+	if err != nil {
+		return ""
+	}
+	dir := filepath.Join(filepath.Dir(executable), "vendor")
+	if _, err := os.Stat(filepath.Join(dir, "modules.txt")); err != nil {
+		return ""
+	}
+	return dir
+}
+
+// checkVendor checks that the vendor directory holds the required versions
+// of the modules, so that go accepts it
+func checkVendor(dir string, requires []grader.Requirement) error {
+	file, err := os.Open(filepath.Join(dir, "modules.txt"))
+	if err != nil {
+		return fmt.Errorf("the bundled vendor directory can't be read: %w", err)
+	}
+	defer file.Close()
+
+	vendored := make(map[string]string)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) >= 3 && fields[0] == "#" {
+			vendored[fields[1]] = fields[2]
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("the bundled vendor directory can't be read: %w", err)
+	}
+
+	for _, r := range requires {
+		if vendored[r.Path] != r.Version {
+			return fmt.Errorf("the bundled vendor directory at %s does not hold %s %s", dir, r.Path, r.Version)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,231 @@
+// Package workspace manages the directories learners write their exercise
+// programs in. Every exercise has a subdirectory of one workspace root.
+package workspace
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gocli-teacher/progress"
+	"gocli-teacher/utils"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// EnvVar names the environment variable that sets the workspace root
+const EnvVar = "GOCLI_TEACHER_WORKSPACE"
+
+// DefaultName is the directory in the user's home that is the workspace
+// root unless another one is set
+const DefaultName = "gocli-teacher"
+
+// ErrAborted is returned by Setup when the learner chose to leave their
+// files alone and stop
+var ErrAborted = errors.New("aborted")
+
+// config is the workspace setting saved in the config directory
+type config struct {
+	Root string `json:"root"`
+}
+
+// configPath returns the file the workspace setting is saved in
+func configPath() (string, error) {
+	configDir, err := progress.ConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get config directory: %w", err)
+	}
+	return filepath.Join(configDir, "workspace.json"), nil
+}
+
+// Root returns the absolute workspace root. The root given, usually from a
+// flag, comes first, then EnvVar, then the saved root, then DefaultName in
+// the home directory.
+func Root(root string) (string, error) {
+	if root == "" {
+		root = os.Getenv(EnvVar)
+	}
+	if root == "" {
+		saved, err := savedRoot()
+		if err != nil {
+			return "", err
+		}
+		root = saved
+	}
+	if root == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("failed to find home directory: %w", err)
+		}
+		root = filepath.Join(home, DefaultName)
+	}
+	return filepath.Abs(root)
+}
+
+// savedRoot returns the root saved with SetRoot, or "" if there is none
+func savedRoot() (string, error) {
+	path, err := configPath()
+	if err != nil {
+		return "", err
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read workspace setting: %w", err)
+	}
+
+	var c config
+	if err := json.Unmarshal(data, &c); err != nil {
+		return "", fmt.Errorf("failed to parse workspace setting: %w", err)
+	}
+	return c.Root, nil
+}
+
+// SetRoot saves root as the workspace root for later runs
+func SetRoot(root string) error {
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return err
+	}
+	path, err := configPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(config{Root: root}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal workspace setting: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write workspace setting: %w", err)
+	}
+	return nil
+}
+
+// Legacy returns name in the current directory, where exercise programs
+// were written before there was a workspace root, if a program is there
+func Legacy(name string) (string, bool) {
+	dir, err := filepath.Abs(name)
+	if err != nil {
+		return "", false
+	}
+	if _, err := os.Stat(filepath.Join(dir, "main.go")); err != nil {
+		return "", false
+	}
+	return dir, true
+}
+
+// Move moves the exercise directory dir to dest, which must not exist yet
+func Move(dir, dest string) error {
+	if _, err := os.Stat(dest); err == nil {
+		return fmt.Errorf("%s already exists", dest)
+	}
+	if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+	if err := os.Rename(dir, dest); err == nil {
+		return nil
+	}
+
+	// Renaming fails across file systems, so copy the files instead
+	if err := utils.CopyDir(dir, dest); err != nil {
+		os.RemoveAll(dest)
+		return fmt.Errorf("failed to move %s: %w", dir, err)
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		return fmt.Errorf("copied %s, but failed to remove it: %w", dir, err)
+	}
+	return nil
+}
+
+// Choice is what Setup did with the exercise's directory
+type Choice int
+
+const (
+	Created Choice = iota // The files were written to a new directory, or one holding the same files
+	Resumed               // The learner kept their files; only missing ones were written
+	Reset                 // The learner's files were backed up and replaced
+)
+
+// Setup writes the starting files of an exercise, mapped by name, into dir.
+// When dir already holds other versions of them, the learner chooses to
+// resume with their own files, back them up and start over, or abort.
+func Setup(dir string, files map[string]string) (Choice, error) {
+	changed := changedFiles(dir, files)
+	if len(changed) == 0 {
+		return Created, writeFiles(dir, files, true)
+	}
+
+	fmt.Printf("\nYou already have work in %s:\n", dir)
+	for _, name := range changed {
+		fmt.Printf("  %s\n", name)
+	}
+	fmt.Println("")
+
+	options := []string{
+		"Resume with your files",
+		"Back up your files and start over",
+		"Abort and leave your files alone",
+	}
+	switch utils.AskChoice("What would you like to do?", options, 0) {
+	case 0:
+		return Resumed, writeFiles(dir, files, false)
+	case 1:
+		backup, err := backUp(dir, time.Now())
+		if err != nil {
+			return Reset, err
+		}
+		fmt.Printf("\nYour files were moved to %s\n", backup)
+		return Reset, writeFiles(dir, files, true)
+	default:
+		return Resumed, ErrAborted
+	}
+}
+
+// changedFiles returns the names of the files in dir that differ from the
+// ones to be written
+func changedFiles(dir string, files map[string]string) []string {
+	var changed []string
+	for name, content := range files {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err == nil && string(data) != content {
+			changed = append(changed, name)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+// writeFiles writes files into dir, replacing existing ones only if
+// replace is set
+func writeFiles(dir string, files map[string]string, replace bool) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if _, err := os.Stat(path); err == nil && !replace {
+			continue
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// backUp moves dir aside to a sibling named after the time and returns
+// the sibling's path
+func backUp(dir string, now time.Time) (string, error) {
+	backup := fmt.Sprintf("%s-backup-%s", filepath.Clean(dir), now.Format("20060102-150405"))
+	if err := os.Rename(dir, backup); err != nil {
+		return "", fmt.Errorf("failed to back up your files: %w", err)
+	}
+	return backup, nil
+}
@@ -0,0 +1,175 @@
+package workspace
+
+import (
+	"gocli-teacher/utils"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// starter is the set of files an exercise starts with
+var starter = map[string]string{
+	"main.go": "package main\n\nfunc main() {\n\t// TODO\n}\n",
+	"go.mod":  "module program\n\ngo 1.22\n",
+}
+
+// write creates files in dir
+func write(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// read returns the content of a file in dir, or "" if it is missing
+func read(t *testing.T, dir, name string) string {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join(dir, name))
+	if err != nil && !os.IsNotExist(err) {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestChangedFiles(t *testing.T) {
+	dir := t.TempDir()
+	if got := changedFiles(filepath.Join(dir, "missing"), starter); got != nil {
+		t.Errorf("missing directory: got %q, want none", got)
+	}
+
+	write(t, dir, map[string]string{"main.go": starter["main.go"]})
+	if got := changedFiles(dir, starter); got != nil {
+		t.Errorf("same and missing files: got %q, want none", got)
+	}
+
+	write(t, dir, map[string]string{"main.go": "package main\n", "go.mod": "module mine\n", "notes.txt": "mine"})
+	if got, want := changedFiles(dir, starter), []string{"go.mod", "main.go"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("changed files: got %q, want %q", got, want)
+	}
+}
+
+func TestSetup(t *testing.T) {
+	utils.TestMode = true
+	defer func() { utils.TestMode = false }()
+
+	t.Run("new directory", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "exercise")
+		choice, err := Setup(dir, starter)
+		if err != nil || choice != Created {
+			t.Fatalf("got %v, %v; want Created", choice, err)
+		}
+		if read(t, dir, "main.go") != starter["main.go"] || read(t, dir, "go.mod") != starter["go.mod"] {
+			t.Error("the starting files were not written")
+		}
+	})
+
+	t.Run("same files", func(t *testing.T) {
+		dir := t.TempDir()
+		write(t, dir, map[string]string{"main.go": starter["main.go"]})
+		choice, err := Setup(dir, starter)
+		if err != nil || choice != Created {
+			t.Fatalf("got %v, %v; want Created", choice, err)
+		}
+		if read(t, dir, "go.mod") != starter["go.mod"] {
+			t.Error("the missing go.mod was not written")
+		}
+	})
+
+	t.Run("learner's work", func(t *testing.T) {
+		// Test mode chooses the first option, resuming
+		dir := t.TempDir()
+		mine := "package main\n\nfunc main() { println(\"mine\") }\n"
+		write(t, dir, map[string]string{"main.go": mine})
+		choice, err := Setup(dir, starter)
+		if err != nil || choice != Resumed {
+			t.Fatalf("got %v, %v; want Resumed", choice, err)
+		}
+		if read(t, dir, "main.go") != mine {
+			t.Error("the learner's main.go was replaced")
+		}
+		if read(t, dir, "go.mod") != starter["go.mod"] {
+			t.Error("the missing go.mod was not written")
+		}
+	})
+}
+
+func TestBackUp(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "simple_cli_exercise")
+	write(t, dir, map[string]string{"main.go": "mine"})
+
+	backup, err := backUp(dir, time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := dir + "-backup-20260102-150405"; backup != want {
+		t.Errorf("backed up to %s, want %s", backup, want)
+	}
+	if read(t, backup, "main.go") != "mine" {
+		t.Error("the backup does not hold the learner's file")
+	}
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Error("the directory is still there after backing it up")
+	}
+
+	// A second backup in the same second does not overwrite the first
+	write(t, dir, map[string]string{"main.go": "again"})
+	if _, err := backUp(dir, time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC)); err == nil {
+		t.Error("backing up over an existing backup succeeded")
+	}
+	if read(t, backup, "main.go") != "mine" || read(t, dir, "main.go") != "again" {
+		t.Error("a failed backup changed the files")
+	}
+}
+
+func TestLegacyAndMove(t *testing.T) {
+	cwd := t.TempDir()
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(cwd); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+
+	if _, ok := Legacy("simple_cli_exercise"); ok {
+		t.Error("found a program in an empty directory")
+	}
+	write(t, filepath.Join(cwd, "simple_cli_exercise"), map[string]string{"go.mod": "module mine\n"})
+	if _, ok := Legacy("simple_cli_exercise"); ok {
+		t.Error("found a program in a directory without main.go")
+	}
+	write(t, filepath.Join(cwd, "simple_cli_exercise"), map[string]string{"main.go": "mine"})
+	legacy, ok := Legacy("simple_cli_exercise")
+	if !ok {
+		t.Fatal("did not find the program in the current directory")
+	}
+
+	root := filepath.Join(t.TempDir(), "gocli-teacher")
+	taken := filepath.Join(root, "taken")
+	write(t, taken, map[string]string{"main.go": "other"})
+	if err := Move(legacy, taken); err == nil {
+		t.Error("moving over an existing directory succeeded")
+	}
+	if read(t, taken, "main.go") != "other" || read(t, legacy, "main.go") != "mine" {
+		t.Error("a failed move changed the files")
+	}
+
+	dest := filepath.Join(root, "simple_cli_exercise")
+	if err := Move(legacy, dest); err != nil {
+		t.Fatal(err)
+	}
+	if read(t, dest, "main.go") != "mine" || read(t, dest, "go.mod") != "module mine\n" {
+		t.Error("the program was not moved")
+	}
+	if _, ok := Legacy("simple_cli_exercise"); ok {
+		t.Error("the program is still in the current directory after moving it")
+	}
+}